@@ -2,7 +2,12 @@ package signals
 
 import (
 	"context"
+	"runtime/debug"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // keyedListener represents a listener paired with an optional identification key.
@@ -19,6 +24,44 @@ type keyedListener[T any] struct {
 	// listenerErr is an error-returning listener variant used by TryEmit.
 	// When present, it takes precedence over the standard listener.
 	listenerErr SignalListenerErr[T]
+
+	// priority orders listeners; higher priorities are invoked first
+	priority int
+
+	// after and before hold the keys of listeners this listener must run after or
+	// before; keys that are not registered are ignored
+	after  []string
+	before []string
+
+	// seq is the registration sequence number, used to keep registration order
+	// among listeners that are otherwise unordered and to identify the listener
+	seq uint64
+
+	// once is set for one-shot listeners and flips to true on first delivery. It is
+	// a pointer so that every snapshot shares the same flag.
+	once *atomic.Bool
+
+	// handle is the Subscription returned for the listener, if any; it is marked
+	// inactive when the listener is removed
+	handle *Subscription
+
+	// muted listeners are skipped by emits until they are unmuted
+	muted bool
+
+	// timeout, if positive, bounds the context of each invocation; with strictTimeout
+	// the emitter stops waiting for the listener at the deadline
+	timeout       time.Duration
+	strictTimeout bool
+
+	// retry is the retry policy of an error-returning listener, nil to not retry
+	retry *RetryPolicy
+
+	// breaker is the listener's circuit breaker, if any; like once, it is shared by
+	// every snapshot
+	breaker *circuitBreaker
+
+	// meta holds the metadata reported by Listeners; set for every registered listener
+	meta *listenerMeta
 }
 
 // BaseSignal provides the foundational implementation for signal management.
@@ -28,6 +71,8 @@ type keyedListener[T any] struct {
 //
 // The BaseSignal uses an optimized storage strategy with both a slice for ordered
 // iteration and a map for O(1) key lookups, ensuring efficient operations at scale.
+// The slice is copy-on-write: every change publishes an immutable snapshot through an
+// atomic pointer, so emitters read the listeners without locking or copying.
 //
 // Example:
 //
@@ -40,24 +85,67 @@ type keyedListener[T any] struct {
 //		// Custom implementation for emitting the signal
 //	}
 type BaseSignal[T any] struct {
-	// mu protects concurrent access to subscribers
-	mu sync.RWMutex
+	// mu serializes changes to the listener list; readers never take it
+	mu sync.Mutex
 
-	// subscribers maintains the ordered list of registered listeners
+	// subscribers maintains the ordered list of registered listeners. Elements
+	// within its length are never modified once published; changes either append
+	// beyond the length or build a new slice.
 	subscribers []keyedListener[T]
 
+	// snapshot is the published copy of subscribers read by Emit, Len and IsEmpty
+	snapshot atomic.Pointer[[]keyedListener[T]]
+
 	// subscribersMap provides O(1) lookup for keyed listeners to prevent duplicates
 	subscribersMap map[string]struct{}
 
 	// growthFunc determines capacity allocation when the subscriber list needs to grow
 	growthFunc func(currentCap int) int
+
+	// name identifies the signal in panic reports
+	name string
+
+	// repanic re-raises recovered listener panics after they have been reported
+	repanic bool
+
+	// isolatePanics makes SyncSignal recover panics of each listener individually
+	isolatePanics bool
+
+	// stableOrder makes RemoveListener keep the registration order of the remaining
+	// listeners instead of using swap-remove
+	stableOrder bool
+
+	// prioritized is set once a listener with a non-zero priority is registered;
+	// removal is then always order-preserving so that priorities stay sorted
+	prioritized bool
+
+	// constrained is set once a listener with After or Before constraints is
+	// registered; the list is then re-sorted on every add and remove
+	constrained bool
+
+	// nextSeq is the sequence number assigned to the next registered listener
+	nextSeq uint64
+
+	// panicMu protects panicHandler
+	panicMu sync.RWMutex
+	// panicHandler receives listener panics recovered by the signal
+	panicHandler func(*PanicError)
+
+	// pause holds the Pause/Resume state
+	pause pauseState[T]
+	// pausePolicy and pauseBufferSize configure what happens to emits while paused
+	pausePolicy     PausePolicy
+	pauseBufferSize int
+
+	// lifecycle holds the OnFirstListener and OnLastListenerRemoved hooks
+	lifecycle lifecycleState
 }
 
 // SignalOptions allows advanced users to customize memory allocation and growth behavior
 // for the subscriber list. This is useful for optimizing performance when the expected
 // number of listeners is known in advance, or when a specific growth pattern is desired.
 //
-// Both fields are optional; if not specified, sensible defaults based on prime numbers
+// All fields are optional; if not specified, sensible defaults based on prime numbers
 // will be used to minimize memory fragmentation and optimize cache locality.
 type SignalOptions struct {
 	// InitialCapacity sets the initial capacity for the subscribers slice.
@@ -68,8 +156,76 @@ type SignalOptions struct {
 	// It receives the current capacity and returns the desired new capacity.
 	// Default uses a sequence of prime numbers for optimal performance.
 	GrowthFunc func(currentCap int) int
+
+	// WorkerPoolSize enables a fixed-size worker pool for AsyncSignal. When greater
+	// than zero, listener invocations are queued and executed by this many goroutines
+	// instead of spawning one goroutine per listener on every emit.
+	// Default is 0 (no pool). Ignored by SyncSignal.
+	WorkerPoolSize int
+
+	// QueueSize sets the capacity of the worker pool queue. Only used when
+	// WorkerPoolSize is set. Default is 1024.
+	QueueSize int
+
+	// QueueFullPolicy determines what happens when the worker pool queue is full.
+	// Only used when WorkerPoolSize is set. Default is QueueFullBlock.
+	QueueFullPolicy QueueFullPolicy
+
+	// Name identifies the signal in PanicError reports. Default is empty.
+	Name string
+
+	// RepanicOnPanic re-raises a recovered listener panic, wrapped in a *PanicError,
+	// after it has been passed to the panic handler. Use it to let crash reporting
+	// see listener bugs instead of keeping the process alive. Default is false.
+	RepanicOnPanic bool
+
+	// IsolatePanics makes SyncSignal recover a panic in each listener individually.
+	// Emit reports the panic to the panic handler and continues with the next
+	// listener; TryEmit returns it as a *PanicError. Without it, a panicking listener
+	// unwinds through the emitter and skips the remaining listeners.
+	// Default is false. AsyncSignal always recovers listener panics.
+	IsolatePanics bool
+
+	// ListenerOrder controls whether removing a listener preserves the order of the
+	// remaining listeners. Default is OrderDefault: stable for SyncSignal, swap-remove
+	// for AsyncSignal and BaseSignal.
+	ListenerOrder ListenerOrder
+
+	// PausePolicy determines what happens to emits while the signal is paused.
+	// Default is PauseDrop.
+	PausePolicy PausePolicy
+
+	// PauseBufferSize is the number of emits buffered while paused with PauseBuffer.
+	// Default is 1024.
+	PauseBufferSize int
+
+	// ListenerContext selects the context AsyncSignal passes to listeners. With
+	// ContextDetached, listeners receive context.WithoutCancel of the emit context:
+	// its values are kept, but listeners are not cut off when the emitter's context
+	// is cancelled, e.g. when an HTTP handler emits and returns. Default is
+	// ContextDefault, which passes the emit context as is. Only used by AsyncSignal.
+	ListenerContext ListenerContext
+
+	// DetachedTimeout gives every listener invocation that receives a detached
+	// context its own timeout. Default is 0, no timeout. Only used by AsyncSignal.
+	DetachedTimeout time.Duration
 }
 
+// ListenerOrder selects how RemoveListener treats the order of the remaining listeners.
+type ListenerOrder int
+
+const (
+	// OrderDefault uses OrderStable for SyncSignal and OrderUnstable otherwise.
+	OrderDefault ListenerOrder = iota
+
+	// OrderStable keeps listeners in registration order when one is removed.
+	OrderStable
+
+	// OrderUnstable removes listeners by moving the last listener into the freed
+	// slot, which changes the order of the remaining listeners.
+	OrderUnstable
+)
+
 // defaultInitialCapacity is the starting capacity for the subscribers slice.
 // Using a prime number helps with cache locality and memory alignment.
 var defaultInitialCapacity = 11
@@ -97,6 +253,10 @@ func defaultGrowthFunc(currentCap int) int {
 func NewBaseSignal[T any](opts *SignalOptions) *BaseSignal[T] {
 	initCap := defaultInitialCapacity
 	growth := defaultGrowthFunc
+	var name string
+	var repanic, isolatePanics, stableOrder bool
+	var pausePolicy PausePolicy
+	pauseBufferSize := defaultPauseBufferSize
 	if opts != nil {
 		if opts.InitialCapacity > 0 {
 			initCap = opts.InitialCapacity
@@ -104,11 +264,25 @@ func NewBaseSignal[T any](opts *SignalOptions) *BaseSignal[T] {
 		if opts.GrowthFunc != nil {
 			growth = opts.GrowthFunc
 		}
+		name = opts.Name
+		repanic = opts.RepanicOnPanic
+		isolatePanics = opts.IsolatePanics
+		stableOrder = opts.ListenerOrder == OrderStable
+		pausePolicy = opts.PausePolicy
+		if opts.PauseBufferSize > 0 {
+			pauseBufferSize = opts.PauseBufferSize
+		}
 	}
 	return &BaseSignal[T]{
-		subscribers:    make([]keyedListener[T], 0, initCap),
-		subscribersMap: make(map[string]struct{}),
-		growthFunc:     growth,
+		subscribers:     make([]keyedListener[T], 0, initCap),
+		subscribersMap:  make(map[string]struct{}),
+		growthFunc:      growth,
+		name:            name,
+		repanic:         repanic,
+		isolatePanics:   isolatePanics,
+		stableOrder:     stableOrder,
+		pausePolicy:     pausePolicy,
+		pauseBufferSize: pauseBufferSize,
 	}
 }
 
@@ -141,7 +315,8 @@ func (s *BaseSignal[T]) ensureCapacity(extra int) {
 //   - Returns -1 if a keyed listener with the same key already exists (duplicate prevention)
 //
 // Keyed listeners enable targeted removal and prevent accidental duplicates.
-// Listeners without keys cannot be individually removed later.
+// Listeners without keys cannot be individually removed later; use Subscribe to
+// get a handle that removes them.
 //
 // Example:
 //
@@ -152,37 +327,129 @@ func (s *BaseSignal[T]) ensureCapacity(extra int) {
 //	}, "key1")
 //	fmt.Println("Number of subscribers after adding listener:", count)
 func (s *BaseSignal[T]) AddListener(listener SignalListener[T], key ...string) int {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	n, _ := s.add(withKey(keyedListener[T]{listener: listener}, key))
+	return n
+}
 
+// AddListenerWithPriority registers a listener like AddListener, invoked according to
+// the given priority. Listeners with a higher priority run before listeners with a
+// lower one; listeners registered with AddListener have priority 0. Listeners with
+// equal priority keep their registration order.
+//
+// For AsyncSignal the priority determines the order in which listeners are dispatched;
+// they may still complete in any order.
+//
+// Returns the total number of subscribers, or -1 if a listener with the same key exists.
+//
+// Example:
+//
+//	signal := signals.NewSync[Order]()
+//	signal.AddListener(persistOrder, "persist")
+//	signal.AddListenerWithPriority(validateOrder, 100, "validate") // runs first
+func (s *BaseSignal[T]) AddListenerWithPriority(listener SignalListener[T], priority int, key ...string) int {
 	if listener == nil {
 		panic("listener cannot be nil")
 	}
+	n, _ := s.add(withKey(keyedListener[T]{listener: listener, priority: priority}, key))
+	return n
+}
 
+// AddOnceListener registers a one-shot listener that is invoked at most once and then
+// removed from the signal. The guarantee holds under concurrent emits: exactly one
+// emission delivers the payload, and the listener is removed in the same step, so
+// there is no need to call RemoveListener from inside the listener.
+//
+// If the emission stops before reaching the listener (context cancelled, error in
+// TryEmit), the listener stays registered for the next emission.
+//
+// Returns the total number of subscribers, or -1 if a listener with the same key exists.
+//
+// Example:
+//
+//	signal := signals.NewSync[Config]()
+//	signal.AddOnceListener(func(ctx context.Context, cfg Config) {
+//		fmt.Println("first config loaded:", cfg.Version)
+//	})
+func (s *BaseSignal[T]) AddOnceListener(listener SignalListener[T], key ...string) int {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	n, _ := s.add(withKey(keyedListener[T]{listener: listener, once: new(atomic.Bool)}, key))
+	return n
+}
+
+// withKey applies the optional key argument of the AddListener family to sub.
+func withKey[T any](sub keyedListener[T], key []string) keyedListener[T] {
 	if len(key) > 0 {
-		if _, ok := s.subscribersMap[key[0]]; ok {
-			return -1
+		sub.key = key[0]
+		sub.keyed = true
+	}
+	return sub
+}
+
+// add registers sub and keeps the subscriber list ordered by After/Before constraints,
+// descending priority and registration order. It returns the new number of subscribers,
+// or -1 and an error if the key is already registered or the constraints form a cycle.
+func (s *BaseSignal[T]) add(sub keyedListener[T]) (int, error) {
+	sub.meta = sub.meta.registered()
+
+	defer s.runHooks()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sub.keyed {
+		if _, ok := s.subscribersMap[sub.key]; ok {
+			return -1, ErrDuplicateKey
 		}
-		s.ensureCapacity(1)
-		s.subscribersMap[key[0]] = struct{}{}
-		s.subscribers = append(s.subscribers, keyedListener[T]{
-			key:      key[0],
-			keyed:    true,
-			listener: listener,
-		})
+	}
+	s.nextSeq++
+	sub.seq = s.nextSeq
+
+	s.ensureCapacity(1)
+	n := len(s.subscribers)
+	if s.constrained || len(sub.after) > 0 || len(sub.before) > 0 {
+		ordered, err := orderListeners(append(s.subscribers, sub))
+		if err != nil {
+			s.subscribers[:n+1][n] = keyedListener[T]{}
+			return -1, err
+		}
+		s.subscribers = ordered
+		s.constrained = true
+	} else if n == 0 || s.subscribers[n-1].priority >= sub.priority {
+		s.subscribers = append(s.subscribers, sub)
 	} else {
-		s.ensureCapacity(1)
-		s.subscribers = append(s.subscribers, keyedListener[T]{
-			listener: listener,
-		})
+		// Insert after the last listener whose priority is at least sub.priority
+		i := n
+		for i > 0 && s.subscribers[i-1].priority < sub.priority {
+			i--
+		}
+		newSubs := make([]keyedListener[T], n+1, cap(s.subscribers))
+		copy(newSubs, s.subscribers[:i])
+		newSubs[i] = sub
+		copy(newSubs[i+1:], s.subscribers[i:])
+		s.subscribers = newSubs
 	}
+	s.publish()
 
-	return len(s.subscribers)
+	if sub.keyed {
+		s.subscribersMap[sub.key] = struct{}{}
+	}
+	if sub.priority != 0 {
+		s.prioritized = true
+	}
+	return len(s.subscribers), nil
 }
 
 // RemoveListener removes a listener identified by the given key from the signal.
-// This method uses a swap-remove strategy for O(1) deletion, which may change
-// the order of remaining listeners.
+//
+// With OrderStable (the default for SyncSignal), or once any listener has been added
+// with a priority or ordering constraints, the remaining listeners keep their order.
+// Otherwise a swap-remove strategy is used, which moves the last listener into the
+// freed position. Either way removal builds a new list, so emits already in progress
+// are not affected.
 //
 // Parameters:
 //   - key: The unique identifier of the listener to remove
@@ -201,17 +468,14 @@ func (s *BaseSignal[T]) AddListener(listener SignalListener[T], key ...string) i
 //	count := signal.RemoveListener("key1")
 //	fmt.Println("Number of subscribers after removing listener:", count)
 func (s *BaseSignal[T]) RemoveListener(key string) int {
+	defer s.runHooks()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if _, ok := s.subscribersMap[key]; ok {
-		delete(s.subscribersMap, key)
-		n := len(s.subscribers)
 		for i, sub := range s.subscribers {
 			if sub.keyed && sub.key == key {
-				// Swap with last and remove last (swap-remove, avoids allocation)
-				s.subscribers[i] = s.subscribers[n-1]
-				s.subscribers = s.subscribers[:n-1]
+				s.removeAt(i)
 				break
 			}
 		}
@@ -220,6 +484,188 @@ func (s *BaseSignal[T]) RemoveListener(key string) int {
 	return -1
 }
 
+// ReplaceListener atomically replaces the function of the listener registered under
+// key, keeping its position, priority and other options. Concurrent emits see either
+// the old or the new function, never neither. Emits already in progress may still
+// invoke the old function.
+//
+// Returns false if no listener with the given key is registered.
+//
+// Example:
+//
+//	signal.AddListener(handleV1, "handler")
+//	// later, hot-swap the handler without a window with no handler registered
+//	signal.ReplaceListener("handler", handleV2)
+func (s *BaseSignal[T]) ReplaceListener(key string, listener SignalListener[T]) bool {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.replace(key, listener, nil)
+}
+
+// ReplaceListenerWithErr atomically replaces the listener registered under key with
+// an error-returning listener. See ReplaceListener for details.
+func (s *BaseSignal[T]) ReplaceListenerWithErr(key string, listener SignalListenerErr[T]) bool {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.replace(key, nil, listener)
+}
+
+// replace swaps the functions of the listener registered under key.
+func (s *BaseSignal[T]) replace(key string, listener SignalListener[T], listenerErr SignalListenerErr[T]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribersMap[key]; !ok {
+		return false
+	}
+	for i := range s.subscribers {
+		if s.subscribers[i].keyed && s.subscribers[i].key == key {
+			s.updateAt(i, func(sub *keyedListener[T]) {
+				sub.listener = listener
+				sub.listenerErr = listenerErr
+			})
+			return true
+		}
+	}
+	return false
+}
+
+// updateAt applies update to a copy of the listener at index i and publishes it.
+// The caller must hold s.mu.
+func (s *BaseSignal[T]) updateAt(i int, update func(*keyedListener[T])) {
+	// The published slice may be in use by emitters, so build a new one
+	newSubs := make([]keyedListener[T], len(s.subscribers), cap(s.subscribers))
+	copy(newSubs, s.subscribers)
+	update(&newSubs[i])
+	s.subscribers = newSubs
+	s.publish()
+}
+
+// RemoveListenersByTag removes every listener carrying the given tag (see
+// ListenerOptions.Tags), e.g. to disable all listeners of a plugin at once. The
+// remaining listeners keep their order.
+//
+// Returns the number of listeners removed.
+//
+// Example:
+//
+//	signal.AddListenerWithOptions(chargeCard, signals.ListenerOptions{Key: "billing.charge", Tags: []string{"billing"}})
+//	signal.AddListenerWithOptions(sendInvoice, signals.ListenerOptions{Key: "billing.invoice", Tags: []string{"billing"}})
+//	removed := signal.RemoveListenersByTag("billing") // 2
+func (s *BaseSignal[T]) RemoveListenersByTag(tag string) int {
+	return s.removeWhere(func(sub *keyedListener[T]) bool {
+		return sub.meta != nil && slices.Contains(sub.meta.tags, tag)
+	})
+}
+
+// RemoveListenersByPrefix removes every keyed listener whose key starts with prefix.
+// The remaining listeners keep their order.
+//
+// Returns the number of listeners removed.
+//
+// Example:
+//
+//	removed := signal.RemoveListenersByPrefix("billing.")
+func (s *BaseSignal[T]) RemoveListenersByPrefix(prefix string) int {
+	return s.removeWhere(func(sub *keyedListener[T]) bool {
+		return sub.keyed && strings.HasPrefix(sub.key, prefix)
+	})
+}
+
+// removeWhere removes every listener matching match and returns how many were removed.
+func (s *BaseSignal[T]) removeWhere(match func(*keyedListener[T]) bool) int {
+	defer s.runHooks()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The published slice may be in use by emitters, so build a new one
+	var newSubs []keyedListener[T]
+	removed := 0
+	for i := range s.subscribers {
+		sub := &s.subscribers[i]
+		if !match(sub) {
+			if newSubs != nil {
+				newSubs = append(newSubs, *sub)
+			}
+			continue
+		}
+		if newSubs == nil {
+			newSubs = make([]keyedListener[T], i, cap(s.subscribers))
+			copy(newSubs, s.subscribers[:i])
+		}
+		s.forget(sub)
+		removed++
+	}
+	if removed == 0 {
+		return 0
+	}
+	s.subscribers = newSubs
+	if s.constrained {
+		s.subscribers, _ = orderListeners(s.subscribers)
+	}
+	s.publish()
+	return removed
+}
+
+// removeAt removes the listener at index i. The caller must hold s.mu.
+func (s *BaseSignal[T]) removeAt(i int) {
+	s.forget(&s.subscribers[i])
+	// The published slice may be in use by emitters, so build a new one
+	n := len(s.subscribers)
+	newSubs := make([]keyedListener[T], n-1, cap(s.subscribers))
+	if s.stableOrder || s.prioritized || s.constrained {
+		// Keep the following listeners in registration order
+		copy(newSubs, s.subscribers[:i])
+		copy(newSubs[i:], s.subscribers[i+1:])
+	} else {
+		// Move the last listener into the freed slot (swap-remove)
+		copy(newSubs, s.subscribers[:n-1])
+		if i < n-1 {
+			newSubs[i] = s.subscribers[n-1]
+		}
+	}
+	s.subscribers = newSubs
+	if s.constrained {
+		// Removing a listener may drop a transitive constraint; re-sort so that
+		// the remaining listeners fall back to priority and registration order.
+		// Removal cannot introduce a cycle, so the error is always nil.
+		s.subscribers, _ = orderListeners(s.subscribers)
+	}
+	s.publish()
+}
+
+// forget releases the key and subscription of a listener being removed. The caller
+// must hold s.mu.
+func (s *BaseSignal[T]) forget(sub *keyedListener[T]) {
+	if sub.keyed {
+		delete(s.subscribersMap, sub.key)
+	}
+	if sub.handle != nil {
+		sub.handle.release()
+	}
+}
+
+// publish makes the current subscriber list visible to emitters and records a
+// transition between zero and non-zero listeners for the lifecycle hooks. The caller
+// must hold s.mu.
+func (s *BaseSignal[T]) publish() {
+	before := len(s.load())
+	subs := s.subscribers
+	s.snapshot.Store(&subs)
+	s.noteTransition(before, len(subs))
+}
+
+// load returns the published subscriber list. The returned slice must not be
+// modified. It is safe for concurrent use and does not lock.
+func (s *BaseSignal[T]) load() []keyedListener[T] {
+	if p := s.snapshot.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
 // Reset removes all subscribers from the signal, effectively clearing the listener list.
 // This operation is useful for cleanup scenarios, testing, or when you need to
 // reconfigure all listeners from scratch.
@@ -237,19 +683,28 @@ func (s *BaseSignal[T]) RemoveListener(key string) int {
 //	signal.Reset() // Removes all listeners
 //	fmt.Println("Number of subscribers after resetting:", signal.Len())
 func (s *BaseSignal[T]) Reset() {
+	defer s.runHooks()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	for i := range s.subscribers {
+		if h := s.subscribers[i].handle; h != nil {
+			h.release()
+		}
+	}
 	s.subscribers = make([]keyedListener[T], 0)
 	s.subscribersMap = make(map[string]struct{})
+	s.publish()
+	s.prioritized = false
+	s.constrained = false
 }
 
 // Emit is intentionally not implemented in BaseSignal and will panic if called directly.
 // This method must be overridden by derived types (e.g., SyncSignal, AsyncSignal) to
 // implement the specific emission strategy (synchronous vs asynchronous).
 //
-// Derived types should acquire a read lock, copy the subscriber list, and invoke
-// each listener according to their execution model.
+// Derived types should load the published subscriber snapshot and invoke each
+// listener according to their execution model.
 //
 // Example:
 //
@@ -268,16 +723,132 @@ func (s *BaseSignal[T]) Emit(ctx context.Context, payload T) {
 // Len returns the current number of registered subscribers.
 // This method is safe for concurrent use.
 func (s *BaseSignal[T]) Len() int {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return len(s.subscribers)
+	return len(s.load())
 }
 
 // IsEmpty returns true if the signal has no registered subscribers.
 // This is a convenience method equivalent to checking if Len() == 0.
 // This method is safe for concurrent use.
 func (s *BaseSignal[T]) IsEmpty() bool {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return len(s.subscribers) == 0
+	return len(s.load()) == 0
+}
+
+// Name returns the signal name configured through SignalOptions.Name.
+func (s *BaseSignal[T]) Name() string {
+	return s.name
+}
+
+// SetPanicHandler sets the handler that receives listener panics recovered by the
+// signal. The handler is called with a *PanicError carrying the recovered value, the
+// stack trace, the listener key and the signal name. Pass nil to discard panics,
+// which is the default.
+//
+// Example:
+//
+//	signal := signals.NewWithOptions[Order](&signals.SignalOptions{Name: "order.created"})
+//	signal.SetPanicHandler(func(p *signals.PanicError) {
+//		log.Printf("%v\n%s", p, p.Stack)
+//	})
+func (s *BaseSignal[T]) SetPanicHandler(handler func(*PanicError)) {
+	s.panicMu.Lock()
+	s.panicHandler = handler
+	s.panicMu.Unlock()
+}
+
+// newPanicError wraps a recovered value. It must be called from the deferred
+// function that recovered the panic so that the stack trace includes the panicking
+// listener. A *PanicError is returned as is, so that a panic re-raised by the signal
+// keeps the stack trace of the goroutine it was recovered on.
+func (s *BaseSignal[T]) newPanicError(r any, key string) *PanicError {
+	if p, ok := r.(*PanicError); ok {
+		return p
+	}
+	return &PanicError{
+		Value:  r,
+		Stack:  debug.Stack(),
+		Key:    key,
+		Signal: s.name,
+	}
+}
+
+// handlePanic reports a recovered listener panic to the panic handler and re-panics
+// when RepanicOnPanic is set. Like newPanicError, it must be called from the deferred
+// function that recovered the panic.
+func (s *BaseSignal[T]) handlePanic(r any, key string) {
+	s.panicMu.RLock()
+	handler := s.panicHandler
+	s.panicMu.RUnlock()
+	if handler == nil && !s.repanic {
+		return
+	}
+	p := s.newPanicError(r, key)
+	if handler != nil {
+		handler(p)
+	}
+	if s.repanic {
+		panic(p)
+	}
+}
+
+// claim reports whether sub may be invoked. A one-shot listener is claimed by exactly
+// one caller, even across concurrent emits, and is removed from the signal in the same
+// step; every other caller gets false.
+func (s *BaseSignal[T]) claim(sub *keyedListener[T]) bool {
+	if sub.once == nil {
+		return true
+	}
+	defer s.runHooks()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !sub.once.CompareAndSwap(false, true) {
+		return false
+	}
+	for i := range s.subscribers {
+		if s.subscribers[i].seq == sub.seq {
+			s.removeAt(i)
+			break
+		}
+	}
+	return true
+}
+
+// call claims and invokes a single listener and returns its error. A panic raised by
+// the listener is recovered and returned as a *PanicError.
+func (s *BaseSignal[T]) call(ctx context.Context, sub *keyedListener[T], payload T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = s.newPanicError(r, sub.key)
+		}
+	}()
+	if !s.claim(sub) {
+		return nil
+	}
+	return s.invokeListener(ctx, sub, payload)
+}
+
+// invokeListener invokes a single listener that has already been claimed and returns
+// its error. Listeners with a circuit breaker are run by invokeGuarded.
+func (s *BaseSignal[T]) invokeListener(ctx context.Context, sub *keyedListener[T], payload T) error {
+	if sub.breaker != nil {
+		return s.invokeGuarded(ctx, sub, payload)
+	}
+	return s.invokeUnguarded(ctx, sub, payload)
+}
+
+// invokeUnguarded invokes a listener regardless of its circuit breaker. Listeners with
+// a retry policy are run by invokeRetry and listeners with a timeout by invokeTimed.
+func (s *BaseSignal[T]) invokeUnguarded(ctx context.Context, sub *keyedListener[T], payload T) error {
+	if sub.retry != nil && sub.listenerErr != nil {
+		return s.invokeRetry(ctx, sub, payload)
+	}
+	if sub.timeout > 0 {
+		return s.invokeTimed(ctx, sub, payload)
+	}
+	if sub.listenerErr != nil {
+		return sub.listenerErr(ctx, payload)
+	}
+	if sub.listener != nil {
+		sub.listener(ctx, payload)
+	}
+	return nil
 }
@@ -56,3 +56,44 @@ func TestNewBaseSignal_NilGrowthFunc(t *testing.T) {
 		t.Fatal("Expected non-nil BaseSignal")
 	}
 }
+
+func subscriberKeys[T any](s *BaseSignal[T]) []string {
+	keys := make([]string, 0, len(s.subscribers))
+	for _, sub := range s.subscribers {
+		keys = append(keys, sub.key)
+	}
+	return keys
+}
+
+// Test listener order after removal for each ListenerOrder on AsyncSignal
+func TestAsyncSignal_ListenerOrderOnRemoval(t *testing.T) {
+	tests := []struct {
+		name  string
+		order ListenerOrder
+		want  []string
+	}{
+		{"default is swap-remove", OrderDefault, []string{"d", "b", "c"}},
+		{"stable", OrderStable, []string{"b", "c", "d"}},
+		{"unstable", OrderUnstable, []string{"d", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig := NewWithOptions[int](&SignalOptions{ListenerOrder: tt.order})
+			for _, k := range []string{"a", "b", "c", "d"} {
+				sig.AddListener(func(ctx context.Context, v int) {}, k)
+			}
+			sig.RemoveListener("a")
+
+			got := subscriberKeys(sig.baseSignal)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
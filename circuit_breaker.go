@@ -0,0 +1,214 @@
+package signals
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a listener circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed invokes the listener normally and counts its consecutive failures.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen skips the listener; emits report ErrCircuitOpen for it.
+	CircuitOpen
+
+	// CircuitHalfOpen lets a limited number of probe invocations through to decide
+	// whether to close the circuit again.
+	CircuitHalfOpen
+)
+
+// String returns the name of the state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Defaults used when the corresponding CircuitBreaker field is not set.
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+// CircuitBreaker configures a per-listener circuit breaker. Set it with
+// ListenerOptions.CircuitBreaker.
+//
+// The circuit starts closed. After FailureThreshold consecutive failures it opens
+// and the listener is skipped for OpenDuration. It then turns half-open and lets
+// HalfOpenProbes invocations through: if they all succeed the circuit closes, and
+// the first failure opens it again. A failure is an error returned by the listener,
+// including a timeout or a failed retry, or a panic.
+//
+// Example:
+//
+//	signal.AddListenerWithErrOptions(callInventory, signals.ListenerOptions{
+//		Key: "inventory",
+//		CircuitBreaker: &signals.CircuitBreaker{
+//			FailureThreshold: 3,
+//			OpenDuration:     10 * time.Second,
+//			OnStateChange: func(key string, from, to signals.CircuitState) {
+//				log.Printf("listener %s: circuit %s -> %s", key, from, to)
+//			},
+//		},
+//	})
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit.
+	// Defaults to 5.
+	FailureThreshold int
+
+	// OpenDuration is how long the circuit stays open before turning half-open.
+	// Defaults to 30 seconds.
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of successful invocations needed in the half-open
+	// state to close the circuit. Only that many invocations are let through at a
+	// time; the others are skipped as if the circuit were open. Defaults to 1.
+	HalfOpenProbes int
+
+	// OnStateChange, if set, is called after every state change with the listener
+	// key. It is called outside of any lock, from the goroutine that invoked or
+	// skipped the listener.
+	OnStateChange func(key string, from, to CircuitState)
+}
+
+// circuitBreaker is the state of a CircuitBreaker. It is shared by every snapshot of
+// the listener.
+type circuitBreaker struct {
+	key              string
+	failureThreshold int
+	openDuration     time.Duration
+	probes           int
+	onStateChange    func(key string, from, to CircuitState)
+
+	mu    sync.Mutex
+	state CircuitState
+	// gen changes with every state change, so that outcomes of invocations started
+	// in an earlier state are ignored
+	gen uint64
+	// failures counts consecutive failures while closed
+	failures int
+	openedAt time.Time
+	// inFlight and succeeded count the probes while half-open
+	inFlight  int
+	succeeded int
+}
+
+func newCircuitBreaker(key string, config *CircuitBreaker) *circuitBreaker {
+	if config == nil {
+		return nil
+	}
+	b := &circuitBreaker{
+		key:              key,
+		failureThreshold: config.FailureThreshold,
+		openDuration:     config.OpenDuration,
+		probes:           config.HalfOpenProbes,
+		onStateChange:    config.OnStateChange,
+	}
+	if b.failureThreshold < 1 {
+		b.failureThreshold = defaultFailureThreshold
+	}
+	if b.openDuration <= 0 {
+		b.openDuration = defaultOpenDuration
+	}
+	if b.probes < 1 {
+		b.probes = 1
+	}
+	return b
+}
+
+// allow reports whether the listener may be invoked and returns the generation to
+// pass to record.
+func (b *circuitBreaker) allow() (uint64, bool) {
+	b.mu.Lock()
+	from := b.state
+	if b.state == CircuitOpen {
+		if time.Since(b.openedAt) < b.openDuration {
+			b.mu.Unlock()
+			return 0, false
+		}
+		b.transition(CircuitHalfOpen)
+	}
+	allowed := true
+	if b.state == CircuitHalfOpen {
+		if b.inFlight+b.succeeded < b.probes {
+			b.inFlight++
+		} else {
+			allowed = false
+		}
+	}
+	gen, to := b.gen, b.state
+	b.mu.Unlock()
+	b.notify(from, to)
+	return gen, allowed
+}
+
+// record updates the circuit with the outcome of an invocation allowed in generation
+// gen.
+func (b *circuitBreaker) record(gen uint64, ok bool) {
+	b.mu.Lock()
+	from := b.state
+	if gen == b.gen {
+		switch b.state {
+		case CircuitClosed:
+			if ok {
+				b.failures = 0
+			} else if b.failures++; b.failures >= b.failureThreshold {
+				b.transition(CircuitOpen)
+			}
+		case CircuitHalfOpen:
+			b.inFlight--
+			if !ok {
+				b.transition(CircuitOpen)
+			} else if b.succeeded++; b.succeeded >= b.probes {
+				b.transition(CircuitClosed)
+			}
+		}
+	}
+	to := b.state
+	b.mu.Unlock()
+	b.notify(from, to)
+}
+
+// transition moves the circuit to state. It must be called with mu held.
+func (b *circuitBreaker) transition(state CircuitState) {
+	b.state = state
+	b.gen++
+	b.failures, b.inFlight, b.succeeded = 0, 0, 0
+	if state == CircuitOpen {
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *circuitBreaker) notify(from, to CircuitState) {
+	if from != to && b.onStateChange != nil {
+		b.onStateChange(b.key, from, to)
+	}
+}
+
+// invokeGuarded invokes a listener through its circuit breaker, returning
+// a *CircuitOpenError without invoking it while the circuit is open.
+func (s *BaseSignal[T]) invokeGuarded(ctx context.Context, sub *keyedListener[T], payload T) error {
+	b := sub.breaker
+	gen, ok := b.allow()
+	if !ok {
+		return &CircuitOpenError{Key: sub.key}
+	}
+	succeeded := false
+	defer func() {
+		// Also runs when the listener panics, which counts as a failure.
+		b.record(gen, succeeded)
+	}()
+	err := s.invokeUnguarded(ctx, sub, payload)
+	succeeded = err == nil
+	return err
+}
@@ -0,0 +1,111 @@
+package signals
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// EmitOptions configures a single EmitWithOptions or TryEmitWithOptions call: it
+// selects the listeners to invoke and, for AsyncSignal, the context and timeout they
+// receive. The zero value selects every listener and uses the signal's settings,
+// like Emit.
+type EmitOptions struct {
+	// IncludeTags restricts the emission to listeners carrying at least one of the
+	// tags. Empty includes every listener.
+	IncludeTags []string
+
+	// ExcludeTags skips listeners carrying any of the tags. Exclusion takes
+	// precedence over inclusion.
+	ExcludeTags []string
+
+	// ListenerContext overrides SignalOptions.ListenerContext for this emit.
+	// Only used by AsyncSignal.
+	ListenerContext ListenerContext
+
+	// DetachedTimeout overrides SignalOptions.DetachedTimeout for this emit.
+	// Only used by AsyncSignal.
+	DetachedTimeout time.Duration
+}
+
+// ListenerContext selects the context an AsyncSignal passes to its listeners.
+type ListenerContext int
+
+const (
+	// ContextDefault uses the signal's setting, which defaults to ContextAttached.
+	ContextDefault ListenerContext = iota
+
+	// ContextAttached passes the emit context to listeners as is, so they observe
+	// its cancellation and deadline.
+	ContextAttached
+
+	// ContextDetached passes context.WithoutCancel of the emit context to listeners,
+	// optionally with a fresh timeout per listener (see DetachedTimeout).
+	ContextDetached
+)
+
+// selects reports whether a listener with the given metadata is invoked. A nil
+// *EmitOptions selects every listener.
+func (o *EmitOptions) selects(meta *listenerMeta) bool {
+	if o == nil || (len(o.IncludeTags) == 0 && len(o.ExcludeTags) == 0) {
+		return true
+	}
+	var tags []string
+	if meta != nil {
+		tags = meta.tags
+	}
+	for _, tag := range o.ExcludeTags {
+		if slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	if len(o.IncludeTags) == 0 {
+		return true
+	}
+	for _, tag := range o.IncludeTags {
+		if slices.Contains(tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// EmitWithOptions behaves like Emit but only invokes the listeners selected by opts.
+// Tags are assigned through ListenerOptions.Tags.
+//
+// Example:
+//
+//	// Notify every listener except those of the disabled billing plugin
+//	signal.EmitWithOptions(ctx, order, signals.EmitOptions{ExcludeTags: []string{"billing"}})
+func (s *SyncSignal[T]) EmitWithOptions(ctx context.Context, payload T, opts EmitOptions) {
+	s.emit(ctx, payload, &opts)
+}
+
+// TryEmitWithOptions behaves like TryEmit but only invokes the listeners selected by
+// opts.
+func (s *SyncSignal[T]) TryEmitWithOptions(ctx context.Context, payload T, opts EmitOptions) error {
+	return s.tryEmit(ctx, payload, &opts)
+}
+
+// EmitWithOptions behaves like Emit but only dispatches the listeners selected by
+// opts. Tags are assigned through ListenerOptions.Tags.
+//
+// Example:
+//
+//	func handleSignup(w http.ResponseWriter, r *http.Request) {
+//		user := createUser(r)
+//		// Welcome emails must not be cut off when the request ends
+//		userCreated.EmitWithOptions(r.Context(), user, signals.EmitOptions{
+//			ListenerContext: signals.ContextDetached,
+//			DetachedTimeout: 30 * time.Second,
+//		})
+//	}
+func (s *AsyncSignal[T]) EmitWithOptions(ctx context.Context, payload T, opts EmitOptions) {
+	_ = s.emit(ctx, payload, true, &opts)
+}
+
+// TryEmitWithOptions behaves like TryEmit but only dispatches the listeners selected
+// by opts.
+func (s *AsyncSignal[T]) TryEmitWithOptions(ctx context.Context, payload T, opts EmitOptions) error {
+	return s.emit(ctx, payload, false, &opts)
+}
@@ -0,0 +1,184 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrQueueFull is returned when a listener invocation cannot be queued because the
+// worker pool queue of an AsyncSignal is full. See QueueFullPolicy.
+var ErrQueueFull = errors.New("signals: worker pool queue is full")
+
+// ErrClosed is returned when emitting on an AsyncSignal that has been closed.
+var ErrClosed = errors.New("signals: signal is closed")
+
+// ErrPaused is returned when an emit is dropped because the signal is paused, or
+// because Resume is still delivering the emits buffered while it was paused. See
+// PausePolicy.
+var ErrPaused = errors.New("signals: signal is paused")
+
+// ErrCircuitOpen is matched by the *CircuitOpenError reported for a listener that is
+// skipped because its circuit breaker is open. See CircuitBreaker.
+var ErrCircuitOpen = errors.New("signals: listener circuit is open")
+
+// ErrDuplicateKey is returned when a listener is registered with a key that is already
+// in use on the signal.
+var ErrDuplicateKey = errors.New("signals: listener key already registered")
+
+// PanicError describes a listener panic recovered by a signal.
+//
+// It is passed to the handler set with SetPanicHandler, returned (joined with other
+// errors) by AsyncSignal.EmitAndWait, and used as the panic value when
+// SignalOptions.RepanicOnPanic is set.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine, as reported by debug.Stack.
+	Stack []byte
+	// Key is the key of the panicking listener, empty for unkeyed listeners.
+	Key string
+	// Signal is the signal name from SignalOptions.Name, empty if not set.
+	Signal string
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	if e.Signal != "" {
+		return fmt.Sprintf("signals: listener %q of signal %q panicked: %v", e.Key, e.Signal, e.Value)
+	}
+	return fmt.Sprintf("signals: listener %q panicked: %v", e.Key, e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, so that errors.Is and errors.As
+// can match it.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// ListenerError wraps an error returned by, or a panic recovered from, a specific
+// listener. It is produced by SyncSignal.TryEmitAll and AsyncSignal.EmitAndWait so
+// that callers can use errors.As to find out which listener failed.
+type ListenerError struct {
+	// Key is the key of the failing listener, empty for unkeyed listeners.
+	Key string
+	// Index is the position of the listener in the emit order.
+	Index int
+	// Err is the error returned by the listener, or a *PanicError.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ListenerError) Error() string {
+	return fmt.Sprintf("signals: listener %q at position %d: %v", e.Key, e.Index, e.Err)
+}
+
+// Unwrap returns the underlying listener error.
+func (e *ListenerError) Unwrap() error {
+	return e.Err
+}
+
+// newListenerError wraps err in a *ListenerError, or returns nil if err is nil.
+func newListenerError(key string, index int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ListenerError{Key: key, Index: index, Err: err}
+}
+
+// ListenerTimeoutError is returned when a listener registered with
+// ListenerOptions.StrictTimeout is still running at its deadline. The listener is
+// abandoned: it keeps running on its own goroutine but its result is discarded.
+type ListenerTimeoutError struct {
+	// Key is the key of the listener that timed out, empty for unkeyed listeners.
+	Key string
+	// Timeout is the listener timeout from ListenerOptions.Timeout.
+	Timeout time.Duration
+}
+
+// Error implements the error interface.
+func (e *ListenerTimeoutError) Error() string {
+	return fmt.Sprintf("signals: listener %q did not return within %v", e.Key, e.Timeout)
+}
+
+// Unwrap returns context.DeadlineExceeded, so that errors.Is treats a listener
+// timeout like any other deadline.
+func (e *ListenerTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// RetryError is returned when a listener registered with ListenerOptions.Retry still
+// fails after retrying. It wraps the error of the last attempt and, if retrying was
+// cut short by the emit context, the context error.
+type RetryError struct {
+	// Key is the key of the failing listener, empty for unkeyed listeners.
+	Key string
+	// Attempts is the number of times the listener was invoked.
+	Attempts int
+	// Err is the error returned by the last attempt.
+	Err error
+	// ContextErr is ctx.Err() if retrying stopped because the emit context was done,
+	// nil otherwise.
+	ContextErr error
+}
+
+// Error implements the error interface.
+func (e *RetryError) Error() string {
+	attempts := "1 attempt"
+	if e.Attempts != 1 {
+		attempts = fmt.Sprintf("%d attempts", e.Attempts)
+	}
+	if e.ContextErr != nil {
+		return fmt.Sprintf("signals: listener %q failed after %s, retrying stopped (%v): %v", e.Key, attempts, e.ContextErr, e.Err)
+	}
+	return fmt.Sprintf("signals: listener %q failed after %s: %v", e.Key, attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt and, if set, the context error, so
+// that errors.Is matches both.
+func (e *RetryError) Unwrap() []error {
+	if e.ContextErr != nil {
+		return []error{e.Err, e.ContextErr}
+	}
+	return []error{e.Err}
+}
+
+// CircuitOpenError is reported for a listener that is skipped because its circuit
+// breaker is open. It matches ErrCircuitOpen with errors.Is.
+type CircuitOpenError struct {
+	// Key is the key of the skipped listener, empty for unkeyed listeners.
+	Key string
+}
+
+// Error implements the error interface.
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("signals: circuit of listener %q is open", e.Key)
+}
+
+// Unwrap returns ErrCircuitOpen.
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// OrderCycleError is returned when the After and Before constraints of a listener
+// would create a cycle with the listeners already registered. The listener is not
+// added.
+type OrderCycleError struct {
+	// Keys lists the listeners forming the cycle, starting with the listener being
+	// registered. Each must run before the next; the last one must run before the
+	// first. Unkeyed listeners are shown as "(unkeyed)".
+	Keys []string
+}
+
+// Error implements the error interface.
+func (e *OrderCycleError) Error() string {
+	if len(e.Keys) == 0 {
+		return "signals: listener ordering cycle"
+	}
+	return fmt.Sprintf("signals: listener ordering cycle: %s -> %s", strings.Join(e.Keys, " -> "), e.Keys[0])
+}
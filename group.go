@@ -0,0 +1,87 @@
+package signals
+
+import "sync"
+
+// Group collects subscriptions, possibly from signals of different payload types, so
+// that they can be removed together with a single Close, e.g. when a component shuts
+// down.
+//
+// The zero value is an empty group ready to use. A Group is safe for concurrent use,
+// including concurrently with emits on the signals it holds subscriptions of.
+//
+// Example:
+//
+//	var listeners signals.Group
+//	listeners.Add(
+//		orderCreated.Subscribe(c.onOrderCreated),
+//		userDeleted.Subscribe(c.onUserDeleted),
+//	)
+//	defer listeners.Close()
+type Group struct {
+	mu     sync.Mutex
+	subs   []*Subscription
+	closed bool
+}
+
+// Add adds subscriptions to the group. Nil subscriptions, as returned when a
+// registration fails, are ignored. If the group is already closed, the subscriptions
+// are unsubscribed immediately.
+func (g *Group) Add(subs ...*Subscription) {
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+		return
+	}
+	if len(g.subs)+len(subs) > cap(g.subs) {
+		// Drop subscriptions removed by other means before growing
+		active := g.subs[:0]
+		for _, sub := range g.subs {
+			if sub.Active() {
+				active = append(active, sub)
+			}
+		}
+		clear(g.subs[len(active):])
+		g.subs = active
+	}
+	for _, sub := range subs {
+		if sub != nil {
+			g.subs = append(g.subs, sub)
+		}
+	}
+	g.mu.Unlock()
+}
+
+// Len returns the number of subscriptions in the group that are still active.
+func (g *Group) Len() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	n := 0
+	for _, sub := range g.subs {
+		if sub.Active() {
+			n++
+		}
+	}
+	return n
+}
+
+// Close unsubscribes every subscription in the group and returns how many listeners
+// it removed; listeners already removed by other means are not counted. Subscriptions
+// added after Close are unsubscribed immediately. Calling Close again returns 0.
+func (g *Group) Close() int {
+	g.mu.Lock()
+	subs := g.subs
+	g.subs = nil
+	g.closed = true
+	g.mu.Unlock()
+
+	removed := 0
+	for _, sub := range subs {
+		if sub.Unsubscribe() {
+			removed++
+		}
+	}
+	return removed
+}
@@ -0,0 +1,139 @@
+package signals
+
+import "sync/atomic"
+
+// lifecycleState holds the lifecycle hooks of a signal and the transitions waiting to
+// be reported to them. Except for pending, its fields are guarded by BaseSignal.mu.
+type lifecycleState struct {
+	onFirst func()
+	onLast  func()
+
+	// events lists the transitions to report in order: true when the first
+	// listener was added, false when the last one was removed
+	events []bool
+	// draining is set while a goroutine is running hooks
+	draining bool
+	// pending is set while events is not empty; read without locking
+	pending atomic.Bool
+}
+
+// OnFirstListener sets a hook called whenever the signal goes from zero to one or
+// more listeners, e.g. to start a producer only while someone is listening. Pass nil
+// to remove the hook. If the signal already has listeners when the hook is set, it
+// is not called until the next such transition.
+//
+// Hooks run after the change has been made, outside the signal's lock, so they may
+// add or remove listeners themselves. Hooks of a signal never run concurrently and
+// are called in the order of the transitions; a transition that happens while a hook
+// is running is reported by the goroutine running it, after it returns.
+//
+// Example:
+//
+//	changes := signals.New[Change]()
+//	var stop context.CancelFunc
+//	changes.OnFirstListener(func() {
+//		var ctx context.Context
+//		ctx, stop = context.WithCancel(context.Background())
+//		go pollChangeStream(ctx, changes)
+//	})
+//	changes.OnLastListenerRemoved(func() { stop() })
+func (s *BaseSignal[T]) OnFirstListener(hook func()) {
+	s.mu.Lock()
+	s.lifecycle.onFirst = hook
+	s.mu.Unlock()
+}
+
+// OnLastListenerRemoved sets a hook called whenever the last listener is removed,
+// by RemoveListener, Reset, Unsubscribe, the delivery of a one-shot listener or any
+// other removal. Pass nil to remove the hook. See OnFirstListener for details.
+func (s *BaseSignal[T]) OnLastListenerRemoved(hook func()) {
+	s.mu.Lock()
+	s.lifecycle.onLast = hook
+	s.mu.Unlock()
+}
+
+// noteTransition records a change of the number of listeners from before to after
+// if it crosses zero and a hook is set for it. The caller must hold s.mu.
+func (s *BaseSignal[T]) noteTransition(before, after int) {
+	switch {
+	case before == 0 && after > 0 && s.lifecycle.onFirst != nil:
+		s.lifecycle.events = append(s.lifecycle.events, true)
+	case before > 0 && after == 0 && s.lifecycle.onLast != nil:
+		s.lifecycle.events = append(s.lifecycle.events, false)
+	default:
+		return
+	}
+	s.lifecycle.pending.Store(true)
+}
+
+// runHooks calls the lifecycle hooks for the recorded transitions. It must be called
+// without holding s.mu after every change of the listener list.
+func (s *BaseSignal[T]) runHooks() {
+	if !s.lifecycle.pending.Load() {
+		return
+	}
+	s.mu.Lock()
+	if s.lifecycle.draining {
+		// The running goroutine picks up the new transitions
+		s.mu.Unlock()
+		return
+	}
+	s.lifecycle.draining = true
+	s.mu.Unlock()
+
+	done := false
+	defer func() {
+		if !done {
+			// A hook panicked; let later changes report the remaining transitions
+			s.mu.Lock()
+			s.lifecycle.draining = false
+			s.mu.Unlock()
+		}
+	}()
+	for {
+		s.mu.Lock()
+		if len(s.lifecycle.events) == 0 {
+			s.lifecycle.events = nil
+			s.lifecycle.pending.Store(false)
+			s.lifecycle.draining = false
+			s.mu.Unlock()
+			done = true
+			return
+		}
+		first := s.lifecycle.events[0]
+		s.lifecycle.events = s.lifecycle.events[1:]
+		hook := s.lifecycle.onLast
+		if first {
+			hook = s.lifecycle.onFirst
+		}
+		s.mu.Unlock()
+
+		if hook != nil {
+			hook()
+		}
+	}
+}
+
+// OnFirstListener sets the hook called when the first listener is added. See BaseSignal.OnFirstListener for details.
+func (s *SyncSignal[T]) OnFirstListener(hook func()) {
+	s.ensureBase()
+	s.baseSignal.OnFirstListener(hook)
+}
+
+// OnLastListenerRemoved sets the hook called when the last listener is removed. See BaseSignal.OnLastListenerRemoved for details.
+func (s *SyncSignal[T]) OnLastListenerRemoved(hook func()) {
+	s.ensureBase()
+	s.baseSignal.OnLastListenerRemoved(hook)
+}
+
+// OnFirstListener sets the hook called when the first listener is added. Promoted from baseSignal.
+func (s *AsyncSignal[T]) OnFirstListener(hook func()) {
+	s.ensureBase()
+	s.baseSignal.OnFirstListener(hook)
+}
+
+// OnLastListenerRemoved sets the hook called when the last listener is removed. Promoted from baseSignal.
+func (s *AsyncSignal[T]) OnLastListenerRemoved(hook func()) {
+	s.ensureBase()
+	s.baseSignal.OnLastListenerRemoved(hook)
+}
@@ -0,0 +1,184 @@
+package signals
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListenerInfo describes a registered listener. It is returned by Listeners.
+type ListenerInfo struct {
+	// Key is the listener key; empty for unkeyed listeners.
+	Key string
+
+	// Priority is the priority the listener was registered with.
+	Priority int
+
+	// Once is true for one-shot listeners.
+	Once bool
+
+	// Description, Owner and Tags are the metadata set through ListenerOptions.
+	// Owner defaults to the package that registered the listener.
+	Description string
+	Owner       string
+	Tags        []string
+
+	// RegisteredAt is the time the listener was registered.
+	RegisteredAt time.Time
+
+	// CallSite is the file:line that registered the listener, outside this package.
+	CallSite string
+}
+
+// listenerMeta is the metadata of a registered listener. It is shared by every
+// snapshot holding the listener and never modified after registration.
+type listenerMeta struct {
+	description string
+	owner       string
+	tags        []string
+
+	registeredAt time.Time
+	// pcs holds the call stack of the registration, resolved lazily by Listeners
+	pcs [8]uintptr
+}
+
+// registered fills in the registration time and call stack of a listener being added
+// and returns m, or new metadata if m is nil.
+func (m *listenerMeta) registered() *listenerMeta {
+	if m == nil {
+		m = &listenerMeta{}
+	}
+	m.registeredAt = time.Now()
+	// Skip runtime.Callers, registered and add
+	runtime.Callers(3, m.pcs[:])
+	return m
+}
+
+// packagePrefix is the prefix of the function names of this package, used to skip
+// its frames when resolving call sites.
+var packagePrefix = func() string {
+	pc, _, _, _ := runtime.Caller(0)
+	return functionPackage(runtime.FuncForPC(pc).Name()) + "."
+}()
+
+// functionPackage returns the package path of a fully qualified function name.
+func functionPackage(name string) string {
+	slash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
+
+// callSite resolves the first frame outside this package.
+func (m *listenerMeta) callSite() (site, pkg string) {
+	frames := runtime.CallersFrames(m.pcs[:])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !strings.HasPrefix(frame.Function, packagePrefix) {
+			return frame.File + ":" + strconv.Itoa(frame.Line), functionPackage(frame.Function)
+		}
+		if !more {
+			return "", ""
+		}
+	}
+}
+
+// info builds the ListenerInfo of sub.
+func (sub *keyedListener[T]) info() ListenerInfo {
+	info := ListenerInfo{
+		Key:      sub.key,
+		Priority: sub.priority,
+		Once:     sub.once != nil,
+	}
+	if m := sub.meta; m != nil {
+		info.Description = m.description
+		info.Owner = m.owner
+		info.Tags = append([]string(nil), m.tags...)
+		info.RegisteredAt = m.registeredAt
+		var pkg string
+		info.CallSite, pkg = m.callSite()
+		if info.Owner == "" {
+			info.Owner = pkg
+		}
+	}
+	return info
+}
+
+// Keys returns the keys of the registered keyed listeners in invocation order.
+// This method is safe for concurrent use.
+func (s *BaseSignal[T]) Keys() []string {
+	snapshot := s.load()
+	keys := make([]string, 0, len(snapshot))
+	for i := range snapshot {
+		if snapshot[i].keyed {
+			keys = append(keys, snapshot[i].key)
+		}
+	}
+	return keys
+}
+
+// Has reports whether a listener with the given key is registered.
+// This method is safe for concurrent use.
+//
+// Example:
+//
+//	if !orderCreated.Has("audit") {
+//		return errors.New("audit listener not registered")
+//	}
+func (s *BaseSignal[T]) Has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.subscribersMap[key]
+	return ok
+}
+
+// Listeners returns a description of every registered listener in invocation order,
+// including the metadata set through ListenerOptions and the time and call site of
+// the registration. The result is a snapshot; later changes to the signal do not
+// affect it.
+func (s *BaseSignal[T]) Listeners() []ListenerInfo {
+	snapshot := s.load()
+	infos := make([]ListenerInfo, len(snapshot))
+	for i := range snapshot {
+		infos[i] = snapshot[i].info()
+	}
+	return infos
+}
+
+// Keys returns the keys of the registered keyed listeners. Promoted from baseSignal.
+func (s *SyncSignal[T]) Keys() []string {
+	s.ensureBase()
+	return s.baseSignal.Keys()
+}
+
+// Has reports whether a listener with the given key is registered. Promoted from baseSignal.
+func (s *SyncSignal[T]) Has(key string) bool {
+	s.ensureBase()
+	return s.baseSignal.Has(key)
+}
+
+// Listeners describes the registered listeners. Promoted from baseSignal.
+func (s *SyncSignal[T]) Listeners() []ListenerInfo {
+	s.ensureBase()
+	return s.baseSignal.Listeners()
+}
+
+// Keys returns the keys of the registered keyed listeners. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Keys() []string {
+	s.ensureBase()
+	return s.baseSignal.Keys()
+}
+
+// Has reports whether a listener with the given key is registered. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Has(key string) bool {
+	s.ensureBase()
+	return s.baseSignal.Has(key)
+}
+
+// Listeners describes the registered listeners. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Listeners() []ListenerInfo {
+	s.ensureBase()
+	return s.baseSignal.Listeners()
+}
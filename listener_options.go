@@ -0,0 +1,158 @@
+package signals
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ListenerOptions configures a listener registered with AddListenerWithOptions or
+// AddListenerWithErrOptions. The zero value registers an unkeyed listener with
+// priority 0, like AddListener.
+type ListenerOptions struct {
+	// Key identifies the listener for removal and ordering constraints.
+	// Empty registers an unkeyed listener.
+	Key string
+
+	// Priority orders listeners; higher priorities run first. See
+	// BaseSignal.AddListenerWithPriority.
+	Priority int
+
+	// After lists keys of listeners this listener must run after.
+	After []string
+
+	// Before lists keys of listeners this listener must run before.
+	Before []string
+
+	// Once registers a one-shot listener. See BaseSignal.AddOnceListener.
+	Once bool
+
+	// Description and Owner are informational metadata reported by Listeners.
+	// Owner defaults to the package that registered the listener.
+	Description string
+	Owner       string
+
+	// Tags are reported by Listeners and select listeners for RemoveListenersByTag
+	// and EmitOptions.
+	Tags []string
+
+	// Timeout, if positive, bounds each invocation of the listener: it receives a
+	// context derived from the emit context with this timeout. A listener that
+	// ignores its context still holds up the emit unless StrictTimeout is set.
+	Timeout time.Duration
+
+	// StrictTimeout makes the emitter stop waiting for the listener once its Timeout
+	// expires, even if the listener never returns. The listener is then run on a
+	// goroutine of its own and abandoned at the deadline; TryEmit returns a
+	// *ListenerTimeoutError and Emit moves on to the next listener. A panic raised
+	// before the deadline is re-raised on the emitter as a *PanicError; one raised
+	// after the listener was abandoned goes to the panic handler, or is dropped if
+	// none is set. Ignored without a Timeout.
+	StrictTimeout bool
+
+	// Retry retries a failing error-returning listener according to the policy; the
+	// Timeout applies to each attempt. The final failure is reported as a
+	// *RetryError. Ignored for listeners that do not return errors.
+	Retry *RetryPolicy
+
+	// CircuitBreaker stops invoking the listener after repeated failures. While the
+	// circuit is open the listener is skipped: Emit and TryEmit move on to the next
+	// listener, TryEmit returns a *CircuitOpenError if nothing else failed, and
+	// AsyncSignal reports it to the error handler. A retried
+	// listener counts as failed only once its retries are exhausted.
+	CircuitBreaker *CircuitBreaker
+}
+
+// newKeyedListener builds the stored listener from its options.
+func newKeyedListener[T any](listener SignalListener[T], listenerErr SignalListenerErr[T], opts ListenerOptions) keyedListener[T] {
+	var once *atomic.Bool
+	if opts.Once {
+		once = new(atomic.Bool)
+	}
+	var retry *RetryPolicy
+	if opts.Retry != nil {
+		policy := *opts.Retry
+		retry = &policy
+	}
+	return keyedListener[T]{
+		key:           opts.Key,
+		keyed:         opts.Key != "",
+		listener:      listener,
+		listenerErr:   listenerErr,
+		priority:      opts.Priority,
+		after:         append([]string(nil), opts.After...),
+		before:        append([]string(nil), opts.Before...),
+		once:          once,
+		timeout:       opts.Timeout,
+		strictTimeout: opts.StrictTimeout,
+		retry:         retry,
+		breaker:       newCircuitBreaker(opts.Key, opts.CircuitBreaker),
+		meta: &listenerMeta{
+			description: opts.Description,
+			owner:       opts.Owner,
+			tags:        append([]string(nil), opts.Tags...),
+		},
+	}
+}
+
+// AddListenerWithOptions registers a listener configured by opts.
+//
+// After and Before express ordering between keyed listeners, e.g. "inventory-reserve
+// must run after payment-authorize". Constraints referring to keys that are not
+// registered are ignored until such a listener is added, so listeners can be
+// registered in any order. Constraints take precedence over priorities; among
+// listeners that are free to run, higher priorities run first and equal priorities
+// keep registration order. The order is recomputed whenever a listener is added or
+// removed.
+//
+// Returns:
+//   - The total number of subscribers after adding the listener
+//   - -1 and ErrDuplicateKey if a listener with the same key already exists
+//   - -1 and an *OrderCycleError if the constraints would create a cycle
+//
+// Example:
+//
+//	signal := signals.NewSync[Order]()
+//	signal.AddListenerWithOptions(authorizePayment, signals.ListenerOptions{Key: "payment-authorize"})
+//	_, err := signal.AddListenerWithOptions(reserveInventory, signals.ListenerOptions{
+//		Key:   "inventory-reserve",
+//		After: []string{"payment-authorize"},
+//	})
+func (s *BaseSignal[T]) AddListenerWithOptions(listener SignalListener[T], opts ListenerOptions) (int, error) {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.add(newKeyedListener[T](listener, nil, opts))
+}
+
+// AddListenerWithErrOptions registers an error-returning listener configured by opts.
+// See AddListenerWithOptions for details.
+func (s *BaseSignal[T]) AddListenerWithErrOptions(listener SignalListenerErr[T], opts ListenerOptions) (int, error) {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.add(newKeyedListener[T](nil, listener, opts))
+}
+
+// AddListenerWithOptions registers a listener configured by opts. See BaseSignal.AddListenerWithOptions for details.
+func (s *SyncSignal[T]) AddListenerWithOptions(listener SignalListener[T], opts ListenerOptions) (int, error) {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithOptions(listener, opts)
+}
+
+// AddListenerWithErrOptions registers an error-returning listener configured by opts. See BaseSignal.AddListenerWithErrOptions for details.
+func (s *SyncSignal[T]) AddListenerWithErrOptions(listener SignalListenerErr[T], opts ListenerOptions) (int, error) {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErrOptions(listener, opts)
+}
+
+// AddListenerWithOptions adds a listener configured by opts. Promoted from baseSignal.
+func (s *AsyncSignal[T]) AddListenerWithOptions(listener SignalListener[T], opts ListenerOptions) (int, error) {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithOptions(listener, opts)
+}
+
+// AddListenerWithErrOptions adds an error-returning listener configured by opts. Promoted from baseSignal.
+func (s *AsyncSignal[T]) AddListenerWithErrOptions(listener SignalListenerErr[T], opts ListenerOptions) (int, error) {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErrOptions(listener, opts)
+}
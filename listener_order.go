@@ -0,0 +1,125 @@
+package signals
+
+import "sort"
+
+// orderListeners returns subs sorted so that every After and Before constraint
+// between registered listeners is satisfied.
+//
+// Listeners are taken in order of descending priority, then registration order, and
+// each one is preceded by the listeners it must run after that have not run yet. A
+// listener that has to run before another is thus pulled forward to just before it,
+// and otherwise keeps its place.
+//
+// The result is written to a new slice with the same capacity as subs. If the
+// constraints form a cycle, an *OrderCycleError is returned and subs is unchanged.
+func orderListeners[T any](subs []keyedListener[T]) ([]keyedListener[T], error) {
+	n := len(subs)
+	index := make(map[string]int, n)
+	for i := range subs {
+		if subs[i].keyed {
+			index[subs[i].key] = i
+		}
+	}
+
+	// preds[i] lists the listeners that must run before listener i.
+	preds := make([][]int, n)
+	for i := range subs {
+		for _, k := range subs[i].after {
+			if j, ok := index[k]; ok {
+				preds[i] = append(preds[i], j)
+			}
+		}
+		for _, k := range subs[i].before {
+			if j, ok := index[k]; ok {
+				preds[j] = append(preds[j], i)
+			}
+		}
+	}
+
+	less := func(a, b int) bool {
+		if subs[a].priority != subs[b].priority {
+			return subs[a].priority > subs[b].priority
+		}
+		return subs[a].seq < subs[b].seq
+	}
+	rank := make([]int, n)
+	for i := range rank {
+		rank[i] = i
+	}
+	sort.Slice(rank, func(a, b int) bool { return less(rank[a], rank[b]) })
+	for i := range preds {
+		p := preds[i]
+		sort.Slice(p, func(a, b int) bool { return less(p[a], p[b]) })
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make([]int, n)
+	var path []int
+	ordered := make([]keyedListener[T], 0, cap(subs))
+
+	var visit func(i int) *OrderCycleError
+	visit = func(i int) *OrderCycleError {
+		switch state[i] {
+		case done:
+			return nil
+		case visiting:
+			// path holds the chain of listeners waiting on their predecessors;
+			// the cycle starts where i was first entered.
+			start := len(path) - 1
+			for path[start] != i {
+				start--
+			}
+			return newOrderCycleError(subs, path[start:])
+		}
+		state[i] = visiting
+		path = append(path, i)
+		for _, p := range preds[i] {
+			if err := visit(p); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = done
+		ordered = append(ordered, subs[i])
+		return nil
+	}
+
+	for _, i := range rank {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// newOrderCycleError describes a cycle found by orderListeners. waiting lists
+// listeners each of which waits for the next one, so the run order is reversed.
+// The cycle is rotated to start with the most recently registered listener, which
+// is the one whose registration introduced it.
+func newOrderCycleError[T any](subs []keyedListener[T], waiting []int) *OrderCycleError {
+	n := len(waiting)
+	cycle := make([]int, n)
+	newest := 0
+	for i, u := range waiting {
+		cycle[n-1-i] = u
+	}
+	for i, u := range cycle {
+		if subs[u].seq > subs[cycle[newest]].seq {
+			newest = i
+		}
+	}
+	keys := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		u := cycle[(newest+i)%n]
+		if subs[u].keyed {
+			keys = append(keys, subs[u].key)
+		} else {
+			keys = append(keys, "(unkeyed)")
+		}
+	}
+	return &OrderCycleError{Keys: keys}
+}
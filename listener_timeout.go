@@ -0,0 +1,104 @@
+package signals
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Outcomes of a strict listener invocation; whichever side gets there first wins.
+const (
+	timedRunning int32 = iota
+	timedReturned
+	timedAbandoned
+)
+
+// timedResult is what a strict listener goroutine reports back to the emitter.
+type timedResult struct {
+	err error
+	// panic is set if the listener panicked; it is built on the listener goroutine so
+	// that its stack trace includes the listener
+	panic *PanicError
+}
+
+// invokeTimed invokes a listener with a timeout. The listener receives ctx bounded by
+// its timeout. Without strictTimeout it is called directly; with it, it runs on a new
+// goroutine and the emitter waits only until the deadline.
+//
+// A panic raised by a strict listener is re-raised on the emitting goroutine as a
+// *PanicError carrying the listener goroutine's stack, so it is handled as if the
+// listener had run there. If the listener panics after it has been abandoned, the
+// panic is reported to the panic handler.
+func (s *BaseSignal[T]) invokeTimed(ctx context.Context, sub *keyedListener[T], payload T) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tctx, cancel := context.WithTimeout(ctx, sub.timeout)
+	if !sub.strictTimeout {
+		defer cancel()
+		if sub.listenerErr != nil {
+			return sub.listenerErr(tctx, payload)
+		}
+		if sub.listener != nil {
+			sub.listener(tctx, payload)
+		}
+		return nil
+	}
+
+	// The goroutine must not capture sub: that would move the listeners of the
+	// allocation-free paths, which pass sub by address, to the heap.
+	listener, listenerErr, key := sub.listener, sub.listenerErr, sub.key
+	var state atomic.Int32
+	done := make(chan timedResult, 1)
+	go func() {
+		defer cancel()
+		var res timedResult
+		defer func() {
+			if r := recover(); r != nil {
+				res = timedResult{panic: s.newPanicError(r, key)}
+			}
+			if state.CompareAndSwap(timedRunning, timedReturned) {
+				done <- res
+			} else if res.panic != nil {
+				s.reportPanic(res.panic)
+			}
+		}()
+		if listenerErr != nil {
+			res.err = listenerErr(tctx, payload)
+		} else if listener != nil {
+			listener(tctx, payload)
+		}
+	}()
+
+	select {
+	case res := <-done:
+		return res.result()
+	case <-tctx.Done():
+	}
+	if !state.CompareAndSwap(timedRunning, timedAbandoned) {
+		// The listener returned just as the deadline passed.
+		return (<-done).result()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return &ListenerTimeoutError{Key: sub.key, Timeout: sub.timeout}
+}
+
+// result returns the listener error, re-raising a recovered panic.
+func (r timedResult) result() error {
+	if r.panic != nil {
+		panic(r.panic)
+	}
+	return r.err
+}
+
+// reportPanic passes a panic recovered outside of any emit to the panic handler.
+// Unlike handlePanic it never re-panics, since there is no caller to propagate to.
+func (s *BaseSignal[T]) reportPanic(p *PanicError) {
+	s.panicMu.RLock()
+	handler := s.panicHandler
+	s.panicMu.RUnlock()
+	if handler != nil {
+		handler(p)
+	}
+}
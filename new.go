@@ -18,7 +18,7 @@ package signals
 //	signal.Emit(context.Background(), 42) // Blocks until listener completes
 func NewSync[T any]() *SyncSignal[T] {
 	s := &SyncSignal[T]{
-		baseSignal: NewBaseSignal[T](nil),
+		baseSignal: newSyncBaseSignal[T](nil),
 	}
 	return s
 }
@@ -0,0 +1,228 @@
+package signals
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// PausePolicy determines what a paused signal does with emits.
+type PausePolicy int
+
+const (
+	// PauseDrop discards emits while the signal is paused. This is the default policy.
+	PauseDrop PausePolicy = iota
+
+	// PauseBuffer keeps up to SignalOptions.PauseBufferSize emits while the signal is
+	// paused and delivers them in order on Resume. Emits beyond the buffer size are
+	// discarded.
+	PauseBuffer
+)
+
+// defaultPauseBufferSize is the buffer size used when SignalOptions.PauseBufferSize
+// is not set.
+var defaultPauseBufferSize = 1024
+
+// pendingEmit is an emit buffered while the signal is paused.
+type pendingEmit[T any] struct {
+	ctx     context.Context
+	payload T
+	opts    *EmitOptions
+}
+
+// pauseState tracks whether a signal is paused and the emits buffered meanwhile.
+type pauseState[T any] struct {
+	// holding is set while emits are held back; emits read it without locking
+	holding atomic.Bool
+
+	// mu protects the fields below
+	mu       sync.Mutex
+	paused   bool
+	flushing bool
+	pending  []pendingEmit[T]
+}
+
+// Pause stops the delivery of emits until Resume is called; what happens to them in
+// the meantime is set by SignalOptions.PausePolicy. Listeners stay registered. Emits
+// that started before Pause returns may still deliver.
+func (s *BaseSignal[T]) Pause() {
+	s.pause.mu.Lock()
+	s.pause.paused = true
+	s.pause.holding.Store(true)
+	s.pause.mu.Unlock()
+}
+
+// IsPaused reports whether the signal is paused. It returns false as soon as Resume
+// is called, while emits are still held back until the buffered ones are delivered.
+func (s *BaseSignal[T]) IsPaused() bool {
+	s.pause.mu.Lock()
+	defer s.pause.mu.Unlock()
+	return s.pause.paused
+}
+
+// hold reports whether an emit must be held back because the signal is paused or
+// Resume is still delivering buffered emits, which newer emits must not overtake. If
+// buffer is true and the policy allows it, the emit is buffered for Resume and hold
+// returns nil; otherwise it is discarded and hold returns ErrPaused.
+func (s *BaseSignal[T]) hold(ctx context.Context, payload T, opts *EmitOptions, buffer bool) (bool, error) {
+	if !s.pause.holding.Load() {
+		return false, nil
+	}
+	s.pause.mu.Lock()
+	defer s.pause.mu.Unlock()
+	if !s.pause.paused && !s.pause.flushing {
+		return false, nil
+	}
+	if buffer && s.pausePolicy == PauseBuffer && len(s.pause.pending) < s.pauseBufferSize {
+		if opts != nil {
+			o := *opts
+			opts = &o
+		}
+		s.pause.pending = append(s.pause.pending, pendingEmit[T]{ctx: ctx, payload: payload, opts: opts})
+		return true, nil
+	}
+	return true, ErrPaused
+}
+
+// resume unpauses the signal and passes the buffered emits, in order, to deliver.
+// Emits arriving while the buffer is flushed are buffered behind it, so the order
+// of emits is preserved. Flushing stops before the next emit if the signal is paused
+// again; the remaining emits stay buffered for the next Resume. If another goroutine
+// is already flushing, resume only unpauses and leaves the delivery to it.
+func (s *BaseSignal[T]) resume(deliver func(pendingEmit[T])) {
+	s.pause.mu.Lock()
+	defer s.pause.mu.Unlock()
+	if !s.pause.paused {
+		return
+	}
+	s.pause.paused = false
+	if s.pause.flushing {
+		return
+	}
+	s.pause.flushing = true
+	for len(s.pause.pending) > 0 && !s.pause.paused {
+		p := s.pause.pending[0]
+		s.pause.pending[0] = pendingEmit[T]{}
+		s.pause.pending = s.pause.pending[1:]
+		if len(s.pause.pending) == 0 {
+			s.pause.pending = nil
+		}
+		s.pause.mu.Unlock()
+		deliver(p)
+		s.pause.mu.Lock()
+	}
+	s.pause.flushing = false
+	s.pause.holding.Store(s.pause.paused)
+}
+
+// Mute stops the delivery to the listener registered under key until Unmute is
+// called. The listener stays registered and keeps its position. A muted one-shot
+// listener is not consumed.
+//
+// Returns false if no listener with the given key is registered.
+func (s *BaseSignal[T]) Mute(key string) bool {
+	return s.setMuted(key, true)
+}
+
+// Unmute resumes the delivery to a listener muted with Mute.
+//
+// Returns false if no listener with the given key is registered.
+func (s *BaseSignal[T]) Unmute(key string) bool {
+	return s.setMuted(key, false)
+}
+
+// setMuted sets the muted flag of the listener registered under key.
+func (s *BaseSignal[T]) setMuted(key string, muted bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribersMap[key]; !ok {
+		return false
+	}
+	for i := range s.subscribers {
+		if s.subscribers[i].keyed && s.subscribers[i].key == key {
+			if s.subscribers[i].muted != muted {
+				s.updateAt(i, func(sub *keyedListener[T]) { sub.muted = muted })
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Pause stops the delivery of emits until Resume. See BaseSignal.Pause for details.
+//
+// Example:
+//
+//	signal := signals.NewSyncWithOptions[Change](&signals.SignalOptions{
+//		PausePolicy:     signals.PauseBuffer,
+//		PauseBufferSize: 10000,
+//	})
+//	signal.Pause()
+//	runMigration(signal) // emits are buffered
+//	signal.Resume()      // and delivered here
+func (s *SyncSignal[T]) Pause() {
+	s.ensureBase()
+	s.baseSignal.Pause()
+}
+
+// Resume resumes the delivery of emits. With PauseBuffer, the emits buffered while
+// paused are delivered first, in order, like Emit and on the calling goroutine;
+// buffered emits whose context has ended by then are skipped.
+func (s *SyncSignal[T]) Resume() {
+	s.ensureBase()
+	s.baseSignal.resume(func(p pendingEmit[T]) {
+		s.invoke(p.ctx, p.payload, p.opts)
+	})
+}
+
+// IsPaused reports whether the signal is paused. See BaseSignal.IsPaused for details.
+func (s *SyncSignal[T]) IsPaused() bool {
+	s.ensureBase()
+	return s.baseSignal.IsPaused()
+}
+
+// Mute stops the delivery to the listener registered under key. See BaseSignal.Mute for details.
+func (s *SyncSignal[T]) Mute(key string) bool {
+	s.ensureBase()
+	return s.baseSignal.Mute(key)
+}
+
+// Unmute resumes the delivery to a muted listener. See BaseSignal.Unmute for details.
+func (s *SyncSignal[T]) Unmute(key string) bool {
+	s.ensureBase()
+	return s.baseSignal.Unmute(key)
+}
+
+// Pause stops the delivery of emits until Resume. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Pause() {
+	s.ensureBase()
+	s.baseSignal.Pause()
+}
+
+// Resume resumes the delivery of emits. With PauseBuffer, the emits buffered while
+// paused are dispatched first, in order, like Emit.
+func (s *AsyncSignal[T]) Resume() {
+	s.ensureBase()
+	s.baseSignal.resume(func(p pendingEmit[T]) {
+		_ = s.dispatch(p.ctx, p.payload, true, p.opts)
+	})
+}
+
+// IsPaused reports whether the signal is paused. Promoted from baseSignal.
+func (s *AsyncSignal[T]) IsPaused() bool {
+	s.ensureBase()
+	return s.baseSignal.IsPaused()
+}
+
+// Mute stops the delivery to the listener registered under key. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Mute(key string) bool {
+	s.ensureBase()
+	return s.baseSignal.Mute(key)
+}
+
+// Unmute resumes the delivery to a muted listener. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Unmute(key string) bool {
+	s.ensureBase()
+	return s.baseSignal.Unmute(key)
+}
@@ -0,0 +1,121 @@
+package signals
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy configures how an error-returning listener is retried when it fails.
+// Set it with ListenerOptions.Retry.
+//
+// Example:
+//
+//	signal.AddListenerWithErrOptions(saveOrder, signals.ListenerOptions{
+//		Key: "save-order",
+//		Retry: &signals.RetryPolicy{
+//			MaxAttempts: 5,
+//			Backoff:     50 * time.Millisecond,
+//			MaxBackoff:  time.Second,
+//			Jitter:      0.5,
+//			Retryable:   isTransient,
+//		},
+//	})
+type RetryPolicy struct {
+	// MaxAttempts is the total number of invocations, including the first one.
+	// Values below 2 disable retrying.
+	MaxAttempts int
+
+	// Backoff is the delay before the second attempt. Zero retries immediately.
+	Backoff time.Duration
+
+	// Multiplier scales the delay after each failed attempt. Values below 1 default
+	// to 2.
+	Multiplier float64
+
+	// MaxBackoff caps the delay between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction of each delay that is randomized, between 0 and 1: a
+	// delay d becomes a random value between d*(1-Jitter) and d.
+	Jitter float64
+
+	// Retryable reports whether an error is worth retrying. Nil retries every error.
+	Retryable func(error) bool
+}
+
+// delay returns the wait before the attempt following attempt n, counting from 1.
+func (p *RetryPolicy) delay(n int) time.Duration {
+	if p.Backoff <= 0 {
+		return 0
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	// Without MaxBackoff, cap the delay at the largest Duration so that it cannot
+	// overflow to a negative value
+	limit := float64(math.MaxInt64)
+	if p.MaxBackoff > 0 {
+		limit = float64(p.MaxBackoff)
+	}
+	d := float64(p.Backoff)
+	for i := 1; i < n && d < limit; i++ {
+		d *= multiplier
+	}
+	if d >= limit {
+		d = limit
+	}
+	if p.Jitter > 0 {
+		jitter := p.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		d -= d * jitter * rand.Float64()
+	}
+	// float64(math.MaxInt64) rounds up to 2^63, which does not fit in a Duration
+	if d >= float64(math.MaxInt64) {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// invokeRetry invokes an error-returning listener until it succeeds, fails with an
+// error that is not retryable, or runs out of attempts. Retrying stops early when
+// ctx is done, and the *RetryError returned for a failure then also wraps ctx.Err().
+func (s *BaseSignal[T]) invokeRetry(ctx context.Context, sub *keyedListener[T], payload T) error {
+	policy := sub.retry
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	for attempt := 1; ; attempt++ {
+		err := s.invokeAttempt(ctx, sub, payload)
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.MaxAttempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+			return &RetryError{Key: sub.key, Attempts: attempt, Err: err}
+		}
+		if d := policy.delay(attempt); d > 0 {
+			timer := time.NewTimer(d)
+			select {
+			case <-timer.C:
+			case <-done:
+				timer.Stop()
+				return &RetryError{Key: sub.key, Attempts: attempt, Err: err, ContextErr: ctx.Err()}
+			}
+		} else if ctx != nil && ctx.Err() != nil {
+			return &RetryError{Key: sub.key, Attempts: attempt, Err: err, ContextErr: ctx.Err()}
+		}
+	}
+}
+
+// invokeAttempt makes a single attempt of invokeRetry.
+func (s *BaseSignal[T]) invokeAttempt(ctx context.Context, sub *keyedListener[T], payload T) error {
+	if sub.timeout > 0 {
+		return s.invokeTimed(ctx, sub, payload)
+	}
+	return sub.listenerErr(ctx, payload)
+}
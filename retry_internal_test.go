@@ -0,0 +1,36 @@
+package signals
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_DelayDoesNotOverflow(t *testing.T) {
+	for _, p := range []RetryPolicy{
+		{Backoff: time.Millisecond},
+		{Backoff: time.Millisecond, Multiplier: 1000},
+		{Backoff: time.Millisecond, Multiplier: 10, Jitter: 0.5},
+	} {
+		prev := time.Duration(0)
+		for n := 1; n <= 200; n++ {
+			d := p.delay(n)
+			if d <= 0 {
+				t.Fatalf("policy %+v: expected a positive delay at attempt %d, got %v", p, n, d)
+			}
+			if p.Jitter == 0 && d < prev {
+				t.Fatalf("policy %+v: delay decreased at attempt %d: %v < %v", p, n, d, prev)
+			}
+			prev = d
+		}
+	}
+}
+
+func TestRetryPolicy_DelayCappedByMaxBackoff(t *testing.T) {
+	p := RetryPolicy{Backoff: time.Millisecond, Multiplier: 3, MaxBackoff: time.Second}
+	if d := p.delay(2); d != 3*time.Millisecond {
+		t.Fatalf("Expected 3ms before the third attempt, got %v", d)
+	}
+	if d := p.delay(500); d != time.Second {
+		t.Fatalf("Expected the delay to be capped at 1s, got %v", d)
+	}
+}
@@ -99,15 +99,22 @@ type Signal[T any] interface {
 }
 
 // NewWithOptions creates a new async Signal with custom allocation/growth options.
+// Set opts.WorkerPoolSize to dispatch listeners through a bounded worker pool.
 func NewWithOptions[T any](opts *SignalOptions) *AsyncSignal[T] {
-	return &AsyncSignal[T]{
+	s := &AsyncSignal[T]{
 		baseSignal: NewBaseSignal[T](opts),
 	}
+	s.pool = newWorkerPool[T](opts, s.run, s.discard)
+	if opts != nil {
+		s.detach = opts.ListenerContext == ContextDetached
+		s.detachedTimeout = opts.DetachedTimeout
+	}
+	return s
 }
 
 // NewSyncWithOptions creates a new sync Signal with custom allocation/growth options.
 func NewSyncWithOptions[T any](opts *SignalOptions) *SyncSignal[T] {
 	return &SyncSignal[T]{
-		baseSignal: NewBaseSignal[T](opts),
+		baseSignal: newSyncBaseSignal[T](opts),
 	}
 }
@@ -2,7 +2,6 @@ package signals_test
 
 import (
 	"context"
-	"sync"
 	"testing"
 
 	"github.com/maniartech/signals"
@@ -31,8 +30,8 @@ func TestAsyncSignal_EmitZeroAllocations(t *testing.T) {
 		sig.Emit(context.Background(), 1)
 	})
 
-	if allocs == 0 {
-		t.Fatalf("Expected allocations for async emit, got %f", allocs)
+	if allocs != 0 {
+		t.Fatalf("Expected zero allocations for async emit, got %f", allocs)
 	}
 }
 
@@ -73,20 +72,46 @@ func TestAsyncSignal_ConcurrentEmitZeroAllocations(t *testing.T) {
 	sig := signals.New[int]()
 	sig.AddListener(func(ctx context.Context, v int) {})
 
-	allocs := testing.AllocsPerRun(100, func() {
-		var wg sync.WaitGroup
-		for i := 0; i < 10; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
+	const workers = 10
+	tasks := make(chan struct{}, workers)
+	done := make(chan struct{}, workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for range tasks {
 				sig.Emit(context.Background(), 1)
-			}()
+				done <- struct{}{}
+			}
+		}()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := 0; i < workers; i++ {
+			tasks <- struct{}{}
+		}
+		for i := 0; i < workers; i++ {
+			<-done
 		}
-		wg.Wait()
 	})
 
-	if allocs == 0 {
-		t.Fatalf("Expected allocations for concurrent async emit, got %f", allocs)
+	close(tasks)
+
+	if allocs != 0 {
+		t.Fatalf("Expected zero allocations for concurrent async emit, got %f", allocs)
+	}
+}
+
+func TestAsyncSignal_WorkerPoolEmitZeroAllocations(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 2})
+	sig.AddListener(func(ctx context.Context, v int) {})
+	sig.AddListener(func(ctx context.Context, v int) {})
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		sig.Emit(context.Background(), 1)
+	})
+
+	if allocs != 0 {
+		t.Fatalf("Expected zero allocations for worker pool emit, got %f", allocs)
 	}
 }
 
@@ -125,7 +150,7 @@ func TestAsyncSignal_SingleKeyedListenerZeroAllocations(t *testing.T) {
 		sig.Emit(context.Background(), 1)
 	})
 
-	if allocs == 0 {
-		t.Fatalf("Expected allocations for single keyed listener, got %f", allocs)
+	if allocs != 0 {
+		t.Fatalf("Expected zero allocations for single keyed async listener, got %f", allocs)
 	}
 }
@@ -2,7 +2,10 @@ package signals
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // AsyncSignal is a struct that implements the Signal interface.
@@ -13,6 +16,33 @@ import (
 type AsyncSignal[T any] struct {
 	baseSignal *BaseSignal[T]
 	baseOnce   sync.Once
+
+	// handlerMu protects errorHandler
+	handlerMu sync.RWMutex
+	// errorHandler receives errors returned by error-returning listeners
+	errorHandler func(err error, payload T, key string)
+
+	// pool dispatches listener invocations when SignalOptions.WorkerPoolSize is set;
+	// when nil every listener runs in its own goroutine, reused through workers
+	pool    *workerPool[T]
+	workers *idleWorkers[T]
+
+	// detach and detachedTimeout hold SignalOptions.ListenerContext and
+	// SignalOptions.DetachedTimeout
+	detach          bool
+	detachedTimeout time.Duration
+
+	// closed rejects emits once Close has been called
+	closed atomic.Bool
+	// active counts emits being dispatched plus listener invocations that have not
+	// finished; inFlight counts only the listener invocations
+	active   atomic.Int64
+	inFlight atomic.Int64
+	// drainMu protects drained, which is created while Drain waits for active to
+	// reach zero; draining counts the waiting Drain calls
+	drainMu  sync.Mutex
+	drained  chan struct{}
+	draining atomic.Int32
 }
 
 func (s *AsyncSignal[T]) ensureBase() {
@@ -20,6 +50,9 @@ func (s *AsyncSignal[T]) ensureBase() {
 		if s.baseSignal == nil {
 			s.baseSignal = NewBaseSignal[T](nil)
 		}
+		if s.pool == nil {
+			s.workers = newIdleWorkers[T](s.run)
+		}
 	})
 }
 
@@ -29,12 +62,119 @@ func (s *AsyncSignal[T]) AddListener(listener SignalListener[T], key ...string)
 	return s.baseSignal.AddListener(listener, key...)
 }
 
+// AddListenerWithErr adds an error-returning listener to the signal. Promoted from baseSignal.
+//
+// The listener runs in its own goroutine like any other async listener. A non-nil
+// error does not affect the remaining listeners; it is passed to the handler
+// registered with SetErrorHandler, or discarded if no handler is set.
+func (s *AsyncSignal[T]) AddListenerWithErr(listener SignalListenerErr[T], key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErr(listener, key...)
+}
+
+// AddListenerWithPriority adds a listener with a priority. Promoted from baseSignal.
+//
+// Listeners with a higher priority are dispatched first; with a single-worker pool
+// this also determines the order in which they run.
+func (s *AsyncSignal[T]) AddListenerWithPriority(listener SignalListener[T], priority int, key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithPriority(listener, priority, key...)
+}
+
+// AddListenerWithErrPriority adds an error-returning listener with a priority. Promoted from baseSignal.
+func (s *AsyncSignal[T]) AddListenerWithErrPriority(listener SignalListenerErr[T], priority int, key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErrPriority(listener, priority, key...)
+}
+
+// AddOnceListener adds a one-shot listener. Promoted from baseSignal.
+//
+// The listener is invoked by at most one emission, even when emits run concurrently.
+func (s *AsyncSignal[T]) AddOnceListener(listener SignalListener[T], key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddOnceListener(listener, key...)
+}
+
+// AddOnceListenerWithErr adds a one-shot error-returning listener. Promoted from baseSignal.
+func (s *AsyncSignal[T]) AddOnceListenerWithErr(listener SignalListenerErr[T], key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddOnceListenerWithErr(listener, key...)
+}
+
+// SetErrorHandler sets the handler that receives errors returned by listeners
+// registered with AddListenerWithErr. The handler is called from the listener's
+// goroutine with the error, the emitted payload and the listener key (empty for
+// unkeyed listeners). It is meant for logging, metrics and alerting and does not
+// affect the delivery to other listeners. Pass nil to discard errors.
+//
+// Example:
+//
+//	signal := signals.New[User]()
+//	signal.SetErrorHandler(func(err error, user User, key string) {
+//		log.Printf("listener %q failed for user %d: %v", key, user.ID, err)
+//	})
+//	signal.AddListenerWithErr(sendWelcomeEmail, "email")
+func (s *AsyncSignal[T]) SetErrorHandler(handler func(err error, payload T, key string)) {
+	s.handlerMu.Lock()
+	s.errorHandler = handler
+	s.handlerMu.Unlock()
+}
+
+// handleError forwards a listener error to the configured error handler, if any.
+func (s *AsyncSignal[T]) handleError(err error, payload T, key string) {
+	s.handlerMu.RLock()
+	handler := s.errorHandler
+	s.handlerMu.RUnlock()
+	if handler != nil {
+		handler(err, payload, key)
+	}
+}
+
+// SetPanicHandler sets the handler for listener panics. Promoted from baseSignal.
+//
+// Panics raised by listeners during Emit are always recovered; without a handler they
+// are discarded unless SignalOptions.RepanicOnPanic is set.
+func (s *AsyncSignal[T]) SetPanicHandler(handler func(*PanicError)) {
+	s.ensureBase()
+	s.baseSignal.SetPanicHandler(handler)
+}
+
+// Name returns the signal name. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Name() string {
+	s.ensureBase()
+	return s.baseSignal.Name()
+}
+
 // RemoveListener removes a listener from the signal. Promoted from baseSignal.
 func (s *AsyncSignal[T]) RemoveListener(key string) int {
 	s.ensureBase()
 	return s.baseSignal.RemoveListener(key)
 }
 
+// ReplaceListener replaces the listener registered under key. Promoted from baseSignal.
+func (s *AsyncSignal[T]) ReplaceListener(key string, listener SignalListener[T]) bool {
+	s.ensureBase()
+	return s.baseSignal.ReplaceListener(key, listener)
+}
+
+// ReplaceListenerWithErr replaces the listener registered under key with an error-returning listener. Promoted from baseSignal.
+func (s *AsyncSignal[T]) ReplaceListenerWithErr(key string, listener SignalListenerErr[T]) bool {
+	s.ensureBase()
+	return s.baseSignal.ReplaceListenerWithErr(key, listener)
+}
+
+// RemoveListenersByTag removes every listener carrying tag. Promoted from baseSignal.
+func (s *AsyncSignal[T]) RemoveListenersByTag(tag string) int {
+	s.ensureBase()
+	return s.baseSignal.RemoveListenersByTag(tag)
+}
+
+// RemoveListenersByPrefix removes every listener whose key starts with prefix. Promoted from baseSignal.
+func (s *AsyncSignal[T]) RemoveListenersByPrefix(prefix string) int {
+	s.ensureBase()
+	return s.baseSignal.RemoveListenersByPrefix(prefix)
+}
+
 // Reset resets the signal. Promoted from baseSignal.
 func (s *AsyncSignal[T]) Reset() {
 	s.ensureBase()
@@ -53,37 +193,284 @@ func (s *AsyncSignal[T]) IsEmpty() bool {
 	return s.baseSignal.IsEmpty()
 }
 
+// Emit notifies all listeners asynchronously. Each listener is invoked in its own
+// goroutine, or handed to the worker pool when one is configured through
+// SignalOptions.WorkerPoolSize, and Emit returns without waiting for them to finish.
+// Goroutines are reused across emits, so Emit does not allocate in steady state.
+// Errors returned by error-returning listeners are reported to the handler set with
+// SetErrorHandler.
+//
+// With a worker pool and QueueFullError policy, listeners that cannot be queued are
+// reported to the error handler with ErrQueueFull. While the signal is paused, the
+// emit is discarded or buffered according to SignalOptions.PausePolicy.
+//
+// Listeners receive ctx, and an emit whose ctx is done dispatches no further
+// listeners. Set SignalOptions.ListenerContext to ContextDetached to let listeners
+// outlive the emitter's context while keeping its values; a detached emit dispatches
+// every listener even if ctx is already done.
 func (s *AsyncSignal[T]) Emit(ctx context.Context, payload T) {
+	_ = s.emit(ctx, payload, true, nil)
+}
+
+// TryEmit behaves like Emit but reports dispatch failures to the caller instead of
+// the error handler. It does not wait for listeners to finish and never returns
+// listener errors.
+//
+// Returns:
+//   - nil if every listener was dispatched
+//   - context.Err() if the context is done before or while dispatching; a detached
+//     emit (see SignalOptions.ListenerContext) still dispatches every listener and
+//     only returns it if blocking on a full worker pool queue was cut short
+//   - ErrQueueFull if the worker pool queue was full and at least one listener was
+//     dropped (QueueFullDropNewest and QueueFullError policies)
+//   - ErrPaused if the signal is paused and the emit was discarded
+func (s *AsyncSignal[T]) TryEmit(ctx context.Context, payload T) error {
+	return s.emit(ctx, payload, false, nil)
+}
+
+// emit dispatches every listener in the current snapshot, or only those selected by
+// opts when it is not nil. When report is true, listeners rejected under the
+// QueueFullError policy are passed to the error handler.
+func (s *AsyncSignal[T]) emit(ctx context.Context, payload T, report bool, opts *EmitOptions) error {
 	s.ensureBase()
-	if ctx != nil && ctx.Err() != nil {
+	if s.closed.Load() {
+		if report {
+			s.handleError(ErrClosed, payload, "")
+			return nil
+		}
+		return ErrClosed
+	}
+	if ctx != nil && !s.detached(opts) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	if held, err := s.baseSignal.hold(ctx, payload, opts, true); held {
+		if report {
+			return nil
+		}
+		return err
+	}
+	return s.dispatch(ctx, payload, report, opts)
+}
+
+// dispatch hands the listeners selected by opts to their goroutines for emit and
+// Resume.
+func (s *AsyncSignal[T]) dispatch(ctx context.Context, payload T, report bool, opts *EmitOptions) error {
+	snapshot := s.baseSignal.load()
+	if len(snapshot) == 0 {
+		return nil
+	}
+	if !s.enter() {
+		return ErrClosed
+	}
+	defer s.release()
+	lctx, timeout := s.listenerContext(ctx, opts)
+	// A detached emit dispatches every listener even if ctx ends meanwhile
+	cut := ctx
+	if s.detached(opts) {
+		cut = nil
+	}
+
+	var result error
+	for i := range snapshot {
+		if cut != nil {
+			if err := cut.Err(); err != nil {
+				return err
+			}
+		}
+		sub := &snapshot[i]
+		if sub.muted || !opts.selects(sub.meta) {
+			continue
+		}
+		task := asyncTask[T]{ctx: lctx, sub: *sub, payload: payload, timeout: timeout}
+		s.begin()
+		if s.pool == nil {
+			s.workers.dispatch(task)
+			continue
+		}
+		err := s.pool.enqueue(ctx, task)
+		if err == nil {
+			continue
+		}
+		s.finish()
+		if !errors.Is(err, ErrQueueFull) {
+			return err
+		}
+		if report {
+			if s.pool.policy == QueueFullError {
+				s.handleError(err, payload, sub.key)
+			}
+			continue
+		}
+		result = err
+	}
+	return result
+}
+
+// deliver invokes a single listener for Emit. Errors are passed to the error handler
+// and panics are recovered and passed to the panic handler.
+func (s *AsyncSignal[T]) deliver(ctx context.Context, sub *keyedListener[T], payload T) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.baseSignal.handlePanic(r, sub.key)
+		}
+	}()
+	if !s.baseSignal.claim(sub) {
 		return
 	}
+	if err := s.baseSignal.invokeListener(ctx, sub, payload); err != nil {
+		s.handleError(err, payload, sub.key)
+	}
+}
 
-	s.baseSignal.mu.RLock()
-	subscribers := s.baseSignal.subscribers
-	if len(subscribers) == 0 {
-		s.baseSignal.mu.RUnlock()
+// run executes a task taken from the worker pool queue or handed to an idle worker.
+func (s *AsyncSignal[T]) run(task asyncTask[T]) {
+	defer s.finish()
+	if task.timeout > 0 {
+		ctx, cancel := context.WithTimeout(task.ctx, task.timeout)
+		defer cancel()
+		task.ctx = ctx
+	}
+	if task.results != nil {
+		err := s.baseSignal.call(task.ctx, &task.sub, task.payload)
+		task.results <- newListenerError(task.sub.key, task.index, err)
 		return
 	}
-	snapshot := make([]keyedListener[T], len(subscribers))
-	copy(snapshot, subscribers)
-	s.baseSignal.mu.RUnlock()
+	s.deliver(task.ctx, &task.sub, task.payload)
+}
 
+// listenerContext returns the context passed to listeners of an emit and the
+// timeout of each invocation, according to the signal options and opts.
+func (s *AsyncSignal[T]) listenerContext(ctx context.Context, opts *EmitOptions) (context.Context, time.Duration) {
+	if !s.detached(opts) {
+		return ctx, 0
+	}
+	timeout := s.detachedTimeout
+	if opts != nil && opts.DetachedTimeout > 0 {
+		timeout = opts.DetachedTimeout
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithoutCancel(ctx), timeout
+}
+
+// detached reports whether listeners of an emit receive a detached context. The
+// emit context then no longer stops the dispatch of listeners either; it only bounds
+// how long the emitter blocks on a full worker pool queue.
+func (s *AsyncSignal[T]) detached(opts *EmitOptions) bool {
+	if opts != nil {
+		switch opts.ListenerContext {
+		case ContextAttached:
+			return false
+		case ContextDetached:
+			return true
+		}
+	}
+	return s.detach
+}
+
+// discard handles a task dropped from the worker pool queue.
+func (s *AsyncSignal[T]) discard(task asyncTask[T]) {
+	if task.results != nil {
+		task.results <- newListenerError(task.sub.key, task.index, ErrQueueFull)
+	}
+	s.finish()
+}
+
+// EmitAndWait notifies all listeners concurrently, like Emit, but blocks until every
+// listener has returned or the context is done.
+//
+// The returned error joins (see errors.Join) every error returned by error-returning
+// listeners and every recovered listener panic (as a *PanicError), each wrapped in a
+// *ListenerError identifying the listener. If the context ends before all
+// listeners have finished, the errors collected so far are joined with ctx.Err() and
+// EmitAndWait returns without waiting for the remaining listeners, which keep running
+// in the background. With a detached listener context, listeners are dispatched even
+// if the context is already done; EmitAndWait then returns ctx.Err() right away.
+//
+// When a worker pool is configured, listeners run on the pool; listeners that cannot
+// be queued are reported as ErrQueueFull. If the signal is paused, EmitAndWait returns
+// ErrPaused without dispatching any listener.
+//
+// Errors and panics returned from EmitAndWait are not passed to the handlers set with
+// SetErrorHandler and SetPanicHandler.
+//
+// Example:
+//
+//	signal := signals.New[Order]()
+//	signal.AddListenerWithErr(reserveInventory, "inventory")
+//	signal.AddListenerWithErr(chargePayment, "payment")
+//	if err := signal.EmitAndWait(ctx, order); err != nil {
+//		http.Error(w, err.Error(), http.StatusInternalServerError)
+//	}
+func (s *AsyncSignal[T]) EmitAndWait(ctx context.Context, payload T) error {
+	s.ensureBase()
+	if s.closed.Load() {
+		return ErrClosed
+	}
+	if ctx != nil && !s.detached(nil) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	if held, err := s.baseSignal.hold(ctx, payload, nil, false); held {
+		return err
+	}
+
+	snapshot := s.baseSignal.load()
+	if len(snapshot) == 0 {
+		return nil
+	}
+	if !s.enter() {
+		return ErrClosed
+	}
+	defer s.release()
+	lctx, timeout := s.listenerContext(ctx, nil)
+
+	// results is buffered so that listeners finishing after EmitAndWait has
+	// returned (context done) never block.
+	results := make(chan error, len(snapshot))
+	var errs []error
+	pending := len(snapshot)
 	for i := range snapshot {
-		if ctx != nil {
-			if err := ctx.Err(); err != nil {
-				break
+		sub := &snapshot[i]
+		if sub.muted {
+			pending--
+			continue
+		}
+		task := asyncTask[T]{ctx: lctx, sub: *sub, payload: payload, timeout: timeout, results: results, index: i}
+		s.begin()
+		if s.pool == nil {
+			s.workers.dispatch(task)
+			continue
+		}
+		err := s.pool.enqueue(ctx, task)
+		if err != nil {
+			s.finish()
+			if !errors.Is(err, ErrQueueFull) {
+				return errors.Join(append(errs, err)...)
 			}
+			errs = append(errs, newListenerError(sub.key, i, err))
+			pending--
 		}
-		sub := &snapshot[i]
-		if sub.listener != nil {
-			listener := sub.listener
-			go func() {
-				defer func() {
-					_ = recover()
-				}()
-				listener(ctx, payload)
-			}()
+	}
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	for ; pending > 0; pending-- {
+		select {
+		case err := <-results:
+			if err != nil {
+				errs = append(errs, err)
+			}
+		case <-done:
+			errs = append(errs, ctx.Err())
+			return errors.Join(errs...)
 		}
 	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,119 @@
+package signals
+
+import "context"
+
+// Close stops the signal from accepting emits. Afterwards Emit reports ErrClosed to
+// the error handler, and TryEmit and EmitAndWait return it. Listener invocations
+// already started keep running; use Drain to wait for them. Once they have finished,
+// the worker pool, if any, is shut down. Close is idempotent.
+//
+// Example:
+//
+//	audit.Close()
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	if err := audit.Drain(ctx); err != nil {
+//		log.Printf("%d audit writes still running: %v", audit.InFlight(), err)
+//	}
+func (s *AsyncSignal[T]) Close() {
+	s.ensureBase()
+	if !s.closed.CompareAndSwap(false, true) {
+		return
+	}
+	if s.active.Load() == 0 && s.pool != nil {
+		s.pool.stop()
+	}
+}
+
+// IsClosed reports whether Close has been called.
+func (s *AsyncSignal[T]) IsClosed() bool {
+	return s.closed.Load()
+}
+
+// InFlight returns the number of listener invocations started by Emit, TryEmit or
+// EmitAndWait that have not finished yet, including those waiting in the worker pool
+// queue.
+func (s *AsyncSignal[T]) InFlight() int {
+	return int(s.inFlight.Load())
+}
+
+// Drain waits until every listener invocation started by an emit has finished or the
+// context is done, in which case it returns ctx.Err(). A nil ctx waits without limit.
+//
+// Drain does not stop new emits; call Close first to make sure the signal becomes
+// idle. Listeners still running when EmitAndWait has returned on its own context are
+// waited for as well.
+func (s *AsyncSignal[T]) Drain(ctx context.Context) error {
+	s.draining.Add(1)
+	defer s.draining.Add(-1)
+
+	s.drainMu.Lock()
+	if s.active.Load() == 0 {
+		s.drainMu.Unlock()
+		return nil
+	}
+	if s.drained == nil {
+		s.drained = make(chan struct{})
+	}
+	drained := s.drained
+	s.drainMu.Unlock()
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	select {
+	case <-drained:
+		return nil
+	case <-done:
+		return ctx.Err()
+	}
+}
+
+// enter registers an emit that is about to dispatch listeners. It returns false if
+// the signal is closed, in which case nothing may be dispatched. Every successful
+// enter must be followed by release.
+func (s *AsyncSignal[T]) enter() bool {
+	s.active.Add(1)
+	if s.closed.Load() {
+		s.release()
+		return false
+	}
+	return true
+}
+
+// begin registers a listener invocation handed to a worker; finish must be called
+// once it has run or been discarded.
+func (s *AsyncSignal[T]) begin() {
+	s.inFlight.Add(1)
+	s.active.Add(1)
+}
+
+// finish marks a listener invocation registered with begin as done.
+func (s *AsyncSignal[T]) finish() {
+	s.inFlight.Add(-1)
+	s.release()
+}
+
+// release decrements active and, when the signal becomes idle, wakes up Drain and
+// shuts down the worker pool of a closed signal.
+//
+// Emits increment active before checking closed and Drain increments draining
+// before checking active, so an emit either sees the signal closed or keeps it
+// active, and Drain either sees the signal idle or is woken up.
+func (s *AsyncSignal[T]) release() {
+	if s.active.Add(-1) != 0 {
+		return
+	}
+	if s.draining.Load() > 0 {
+		s.drainMu.Lock()
+		if s.drained != nil && s.active.Load() == 0 {
+			close(s.drained)
+			s.drained = nil
+		}
+		s.drainMu.Unlock()
+	}
+	if s.closed.Load() && s.pool != nil {
+		s.pool.stop()
+	}
+}
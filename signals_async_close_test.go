@@ -0,0 +1,136 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestAsyncSignal_CloseRejectsEmits(t *testing.T) {
+	sig := signals.New[int]()
+
+	var called atomic.Bool
+	sig.AddListener(func(ctx context.Context, v int) { called.Store(true) })
+	var reported atomic.Value
+	sig.SetErrorHandler(func(err error, payload int, key string) {
+		reported.Store(err)
+	})
+
+	sig.Close()
+	sig.Close()
+	if !sig.IsClosed() {
+		t.Fatal("Expected signal to be closed")
+	}
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, signals.ErrClosed) {
+		t.Fatalf("Expected ErrClosed from TryEmit, got %v", err)
+	}
+	if err := sig.EmitAndWait(context.Background(), 1); !errors.Is(err, signals.ErrClosed) {
+		t.Fatalf("Expected ErrClosed from EmitAndWait, got %v", err)
+	}
+	sig.Emit(context.Background(), 1)
+	if err, _ := reported.Load().(error); !errors.Is(err, signals.ErrClosed) {
+		t.Fatalf("Expected Emit to report ErrClosed, got %v", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if called.Load() {
+		t.Fatal("Expected no listener to run after Close")
+	}
+}
+
+func TestAsyncSignal_DrainWaitsForListeners(t *testing.T) {
+	sig := signals.New[int]()
+
+	release := make(chan struct{})
+	var finished atomic.Int32
+	for i := 0; i < 3; i++ {
+		sig.AddListener(func(ctx context.Context, v int) {
+			<-release
+			finished.Add(1)
+		})
+	}
+
+	sig.Emit(context.Background(), 1)
+	if n := sig.InFlight(); n != 3 {
+		t.Fatalf("Expected 3 listeners in flight, got %d", n)
+	}
+	sig.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := sig.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected DeadlineExceeded while listeners block, got %v", err)
+	}
+
+	close(release)
+	if err := sig.Drain(context.Background()); err != nil {
+		t.Fatalf("Expected Drain to succeed, got %v", err)
+	}
+	if n := finished.Load(); n != 3 {
+		t.Fatalf("Expected all listeners to finish before Drain returns, got %d", n)
+	}
+	if n := sig.InFlight(); n != 0 {
+		t.Fatalf("Expected no listeners in flight, got %d", n)
+	}
+}
+
+func TestAsyncSignal_DrainIdle(t *testing.T) {
+	var sig signals.AsyncSignal[int]
+	if err := sig.Drain(context.Background()); err != nil {
+		t.Fatalf("Expected Drain on an idle signal to return nil, got %v", err)
+	}
+	if sig.InFlight() != 0 || sig.IsClosed() {
+		t.Fatal("Unexpected state for a zero value signal")
+	}
+}
+
+func TestAsyncSignal_DrainWorkerPool(t *testing.T) {
+	base := runtime.NumGoroutine()
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 4, QueueSize: 100})
+
+	var finished atomic.Int32
+	sig.AddListener(func(ctx context.Context, v int) {
+		time.Sleep(time.Millisecond)
+		finished.Add(1)
+	})
+	for i := 0; i < 20; i++ {
+		sig.Emit(context.Background(), i)
+	}
+
+	sig.Close()
+	if err := sig.Drain(context.Background()); err != nil {
+		t.Fatalf("Expected Drain to succeed, got %v", err)
+	}
+	if n := finished.Load(); n != 20 {
+		t.Fatalf("Expected queued listeners to run before Drain returns, got %d", n)
+	}
+
+	waitFor(t, func() bool { return runtime.NumGoroutine() <= base+1 })
+}
+
+func TestAsyncSignal_DrainAfterEmitAndWaitTimeout(t *testing.T) {
+	sig := signals.New[int]()
+
+	release := make(chan struct{})
+	sig.AddListener(func(ctx context.Context, v int) { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := sig.EmitAndWait(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected DeadlineExceeded, got %v", err)
+	}
+	if n := sig.InFlight(); n != 1 {
+		t.Fatalf("Expected the abandoned listener to be in flight, got %d", n)
+	}
+
+	close(release)
+	if err := sig.Drain(context.Background()); err != nil {
+		t.Fatalf("Expected Drain to succeed, got %v", err)
+	}
+}
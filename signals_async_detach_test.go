@@ -0,0 +1,187 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+type ctxKey struct{}
+
+// ctxObservation is what a listener saw of its context.
+type ctxObservation struct {
+	err         error
+	value       any
+	hasDeadline bool
+}
+
+// observeContext registers a listener that waits briefly, then reports its context.
+func observeContext(sig *signals.AsyncSignal[int]) <-chan ctxObservation {
+	seen := make(chan ctxObservation, 1)
+	sig.AddListener(func(ctx context.Context, v int) {
+		time.Sleep(20 * time.Millisecond)
+		_, hasDeadline := ctx.Deadline()
+		seen <- ctxObservation{err: ctx.Err(), value: ctx.Value(ctxKey{}), hasDeadline: hasDeadline}
+	})
+	return seen
+}
+
+func TestAsyncSignal_AttachedContextCancelled(t *testing.T) {
+	sig := signals.New[int]()
+	seen := observeContext(sig)
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "req-1"))
+	sig.Emit(ctx, 1)
+	cancel()
+
+	if got := <-seen; !errors.Is(got.err, context.Canceled) {
+		t.Fatalf("Expected attached listener to see cancellation, got %v", got.err)
+	}
+}
+
+func TestAsyncSignal_DetachedContextSurvivesCancel(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{ListenerContext: signals.ContextDetached})
+	seen := observeContext(sig)
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "req-1"))
+	sig.Emit(ctx, 1)
+	cancel()
+
+	got := <-seen
+	if got.err != nil {
+		t.Fatalf("Expected detached listener not to be cancelled, got %v", got.err)
+	}
+	if got.value != "req-1" {
+		t.Fatalf("Expected context values to be kept, got %v", got.value)
+	}
+	if got.hasDeadline {
+		t.Fatal("Expected no deadline without DetachedTimeout")
+	}
+}
+
+func TestAsyncSignal_DetachedTimeout(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{
+		ListenerContext: signals.ContextDetached,
+		DetachedTimeout: 5 * time.Millisecond,
+		WorkerPoolSize:  1,
+	})
+	seen := observeContext(sig)
+
+	sig.Emit(context.Background(), 1)
+
+	got := <-seen
+	if !got.hasDeadline || !errors.Is(got.err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the listener's own timeout to expire, got %+v", got)
+	}
+}
+
+func TestAsyncSignal_EmitOptionsOverrideListenerContext(t *testing.T) {
+	detached := signals.NewWithOptions[int](&signals.SignalOptions{ListenerContext: signals.ContextDetached})
+	seenDetached := observeContext(detached)
+	attached := signals.New[int]()
+	seenAttached := observeContext(attached)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	detached.EmitWithOptions(ctx, 1, signals.EmitOptions{ListenerContext: signals.ContextAttached})
+	attached.EmitWithOptions(ctx, 1, signals.EmitOptions{
+		ListenerContext: signals.ContextDetached,
+		DetachedTimeout: time.Hour,
+	})
+	cancel()
+
+	if got := <-seenDetached; !errors.Is(got.err, context.Canceled) {
+		t.Fatalf("Expected per-emit attach to pass cancellation, got %v", got.err)
+	}
+	if got := <-seenAttached; got.err != nil || !got.hasDeadline {
+		t.Fatalf("Expected per-emit detach with timeout, got %+v", got)
+	}
+}
+
+func TestAsyncSignal_DetachedEmitAndWait(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{ListenerContext: signals.ContextDetached})
+
+	var listenerErr error
+	done := make(chan struct{})
+	sig.AddListener(func(ctx context.Context, v int) {
+		time.Sleep(20 * time.Millisecond)
+		listenerErr = ctx.Err()
+		close(done)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := sig.EmitAndWait(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected EmitAndWait to stop waiting at its deadline, got %v", err)
+	}
+	<-done
+	if listenerErr != nil {
+		t.Fatalf("Expected detached listener to finish its work, got %v", listenerErr)
+	}
+}
+
+func TestAsyncSignal_DetachedEmitWithCancelledContext(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{ListenerContext: signals.ContextDetached})
+	seen := observeContext(sig)
+	seenSecond := observeContext(sig)
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "req-1"))
+	cancel()
+	if err := sig.TryEmit(ctx, 1); err != nil {
+		t.Fatalf("Expected a detached emit to dispatch despite the cancelled context, got %v", err)
+	}
+
+	for _, ch := range []<-chan ctxObservation{seen, seenSecond} {
+		select {
+		case got := <-ch:
+			if got.err != nil || got.value != "req-1" {
+				t.Fatalf("Expected a live detached context with values, got %+v", got)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected every listener to run")
+		}
+	}
+}
+
+func TestAsyncSignal_DetachedPerEmitWithCancelledContext(t *testing.T) {
+	sig := signals.New[int]()
+	seen := observeContext(sig)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sig.TryEmit(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected an attached emit to be cut off, got %v", err)
+	}
+	sig.EmitWithOptions(ctx, 2, signals.EmitOptions{ListenerContext: signals.ContextDetached})
+
+	select {
+	case got := <-seen:
+		if got.err != nil {
+			t.Fatalf("Expected a live detached context, got %v", got.err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the detached emit to reach the listener")
+	}
+}
+
+func TestAsyncSignal_DetachedEmitAndWaitWithCancelledContext(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{ListenerContext: signals.ContextDetached})
+	seen := observeContext(sig)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sig.EmitAndWait(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected EmitAndWait to stop waiting, got %v", err)
+	}
+
+	select {
+	case got := <-seen:
+		if got.err != nil {
+			t.Fatalf("Expected a live detached context, got %v", got.err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the listener to run")
+	}
+}
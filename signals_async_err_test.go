@@ -0,0 +1,159 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestAsyncSignal_AddListenerWithErrInvokesListener(t *testing.T) {
+	sig := signals.New[int]()
+
+	done := make(chan int, 1)
+	count := sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		done <- v
+		return nil
+	})
+	if count != 1 {
+		t.Fatalf("Expected count 1, got %d", count)
+	}
+
+	sig.Emit(context.Background(), 42)
+
+	select {
+	case v := <-done:
+		if v != 42 {
+			t.Fatalf("Expected payload 42, got %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected error listener to be invoked by async Emit")
+	}
+}
+
+func TestAsyncSignal_AddListenerWithErrDuplicateKey(t *testing.T) {
+	sig := signals.New[int]()
+
+	if n := sig.AddListenerWithErr(func(ctx context.Context, v int) error { return nil }, "k"); n != 1 {
+		t.Fatalf("Expected 1 after first add, got %d", n)
+	}
+	if n := sig.AddListenerWithErr(func(ctx context.Context, v int) error { return nil }, "k"); n != -1 {
+		t.Fatalf("Expected -1 on duplicate key, got %d", n)
+	}
+}
+
+func TestAsyncSignal_ErrorHandlerReceivesErrorPayloadAndKey(t *testing.T) {
+	sig := signals.New[int]()
+
+	type report struct {
+		err     error
+		payload int
+		key     string
+	}
+	reports := make(chan report, 1)
+	sig.SetErrorHandler(func(err error, payload int, key string) {
+		reports <- report{err, payload, key}
+	})
+
+	errBoom := errors.New("boom")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		return errBoom
+	}, "email")
+
+	sig.Emit(context.Background(), 7)
+
+	select {
+	case r := <-reports:
+		if !errors.Is(r.err, errBoom) {
+			t.Fatalf("Expected boom error, got %v", r.err)
+		}
+		if r.payload != 7 {
+			t.Fatalf("Expected payload 7, got %d", r.payload)
+		}
+		if r.key != "email" {
+			t.Fatalf("Expected key email, got %q", r.key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected error handler to be called")
+	}
+}
+
+func TestAsyncSignal_ErrorDoesNotAffectOtherListeners(t *testing.T) {
+	sig := signals.New[int]()
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	sig.SetErrorHandler(func(err error, payload int, key string) {
+		wg.Done()
+	})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		return errors.New("failed")
+	}, "failing")
+	sig.AddListener(func(ctx context.Context, v int) {
+		wg.Done()
+	})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		wg.Done()
+		return nil
+	})
+
+	sig.Emit(context.Background(), 1)
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected all listeners and the error handler to run")
+	}
+}
+
+func TestAsyncSignal_ErrorWithoutHandlerIsDiscarded(t *testing.T) {
+	sig := signals.New[int]()
+
+	done := make(chan struct{})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		defer close(done)
+		return errors.New("ignored")
+	})
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected error listener to run without an error handler")
+	}
+}
+
+func TestAsyncSignal_SetErrorHandlerNilDisablesReporting(t *testing.T) {
+	sig := signals.New[int]()
+
+	called := make(chan struct{}, 1)
+	sig.SetErrorHandler(func(err error, payload int, key string) {
+		called <- struct{}{}
+	})
+	sig.SetErrorHandler(nil)
+
+	done := make(chan struct{})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		defer close(done)
+		return errors.New("ignored")
+	})
+
+	sig.Emit(context.Background(), 1)
+	<-done
+	time.Sleep(10 * time.Millisecond)
+
+	select {
+	case <-called:
+		t.Fatal("Expected no error handler call after SetErrorHandler(nil)")
+	default:
+	}
+}
@@ -59,3 +59,34 @@ func TestAsyncSignal_NoGoroutineLeakAfterManyEmits(t *testing.T) {
 		t.Fatalf("Expected goroutine count to return near baseline; baseline=%d after=%d", base, after)
 	}
 }
+
+func TestAsyncSignal_RepanicOnPanic(t *testing.T) {
+	sig := NewWithOptions[int](&SignalOptions{Name: "sig", RepanicOnPanic: true})
+
+	var handled *PanicError
+	sig.SetPanicHandler(func(p *PanicError) {
+		handled = p
+	})
+
+	sub := keyedListener[int]{
+		key:      "k",
+		keyed:    true,
+		listener: func(context.Context, int) { panic("boom") },
+	}
+
+	defer func() {
+		r := recover()
+		p, ok := r.(*PanicError)
+		if !ok {
+			t.Fatalf("Expected re-panic with *PanicError, got %v", r)
+		}
+		if p.Value != "boom" || p.Key != "k" || p.Signal != "sig" {
+			t.Fatalf("Unexpected panic error %+v", p)
+		}
+		if handled != p {
+			t.Fatal("Expected panic handler to be called before re-panicking")
+		}
+	}()
+	sig.deliver(context.Background(), &sub, 1)
+	t.Fatal("Expected deliver to re-panic")
+}
@@ -0,0 +1,129 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestAsyncSignal_PanicHandlerReceivesPanicError(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{Name: "order.created"})
+
+	reports := make(chan *signals.PanicError, 1)
+	sig.SetPanicHandler(func(p *signals.PanicError) {
+		reports <- p
+	})
+	sig.AddListener(func(ctx context.Context, v int) {
+		panic("nil order")
+	}, "audit")
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case p := <-reports:
+		if p.Value != "nil order" {
+			t.Fatalf("Expected recovered value %q, got %v", "nil order", p.Value)
+		}
+		if p.Key != "audit" {
+			t.Fatalf("Expected key audit, got %q", p.Key)
+		}
+		if p.Signal != "order.created" {
+			t.Fatalf("Expected signal name order.created, got %q", p.Signal)
+		}
+		if !strings.Contains(string(p.Stack), "TestAsyncSignal_PanicHandlerReceivesPanicError") {
+			t.Fatalf("Expected stack trace to include the panicking listener, got:\n%s", p.Stack)
+		}
+		if !strings.Contains(p.Error(), "audit") || !strings.Contains(p.Error(), "order.created") {
+			t.Fatalf("Expected error message to name listener and signal, got %q", p.Error())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected panic handler to be called")
+	}
+}
+
+func TestAsyncSignal_PanicHandlerForErrorListener(t *testing.T) {
+	sig := signals.New[int]()
+
+	errBug := errors.New("bug")
+	reports := make(chan *signals.PanicError, 1)
+	sig.SetPanicHandler(func(p *signals.PanicError) {
+		reports <- p
+	})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		panic(errBug)
+	}, "writer")
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case p := <-reports:
+		if !errors.Is(p, errBug) {
+			t.Fatalf("Expected PanicError to unwrap to the panic value, got %v", p)
+		}
+		if p.Signal != "" {
+			t.Fatalf("Expected empty signal name, got %q", p.Signal)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected panic handler to be called")
+	}
+}
+
+func TestAsyncSignal_PanicHandlerWithWorkerPool(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 1})
+
+	reports := make(chan *signals.PanicError, 1)
+	sig.SetPanicHandler(func(p *signals.PanicError) {
+		reports <- p
+	})
+	sig.AddListener(func(ctx context.Context, v int) {
+		panic("pooled")
+	}, "k")
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case p := <-reports:
+		if p.Value != "pooled" || p.Key != "k" {
+			t.Fatalf("Unexpected panic report %+v", p)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected panic handler to be called for pooled listener")
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitReturnsPanicError(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{Name: "sig"})
+
+	handled := make(chan struct{}, 1)
+	sig.SetPanicHandler(func(p *signals.PanicError) {
+		handled <- struct{}{}
+	})
+	sig.AddListener(func(ctx context.Context, v int) {
+		panic("boom")
+	}, "k")
+
+	err := sig.EmitAndWait(context.Background(), 1)
+	var p *signals.PanicError
+	if !errors.As(err, &p) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if p.Key != "k" || p.Signal != "sig" || p.Value != "boom" {
+		t.Fatalf("Unexpected panic error %+v", p)
+	}
+	select {
+	case <-handled:
+		t.Fatal("Expected panic returned by EmitAndWait not to reach the panic handler")
+	default:
+	}
+}
+
+func TestPanicError_UnwrapNonError(t *testing.T) {
+	p := &signals.PanicError{Value: 42}
+	if p.Unwrap() != nil {
+		t.Fatalf("Expected nil Unwrap for non-error value, got %v", p.Unwrap())
+	}
+}
@@ -0,0 +1,223 @@
+package signals
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// QueueFullPolicy determines what an AsyncSignal backed by a worker pool does when a
+// listener invocation has to be queued and the queue is already full.
+type QueueFullPolicy int
+
+const (
+	// QueueFullBlock blocks the emitter until the queue has room or the emit
+	// context is done. This is the default policy.
+	QueueFullBlock QueueFullPolicy = iota
+
+	// QueueFullDropNewest discards the invocation that could not be queued.
+	QueueFullDropNewest
+
+	// QueueFullDropOldest discards the oldest queued invocation to make room for
+	// the new one.
+	QueueFullDropOldest
+
+	// QueueFullError discards the invocation that could not be queued and reports
+	// ErrQueueFull: TryEmit returns it, Emit passes it to the error handler.
+	QueueFullError
+)
+
+// defaultQueueSize is the queue capacity used when SignalOptions.QueueSize is not set.
+var defaultQueueSize = 1024
+
+// idleWorkerTimeout is how long a goroutine started by an AsyncSignal without a
+// worker pool waits for another listener invocation before it exits.
+var idleWorkerTimeout = 10 * time.Millisecond
+
+// asyncTask is a single listener invocation handed to a worker goroutine.
+// Tasks are passed by value to keep dispatching allocation-free.
+type asyncTask[T any] struct {
+	ctx     context.Context
+	sub     keyedListener[T]
+	payload T
+	// timeout, if positive, bounds ctx for this invocation
+	timeout time.Duration
+
+	// results receives the listener outcome for EmitAndWait; nil for Emit
+	results chan<- error
+	// index is the position of the listener in the EmitAndWait snapshot
+	index int
+}
+
+// workerPool runs listener invocations on a fixed number of goroutines fed by a
+// bounded queue. Workers are started lazily on the first enqueue and exit on stop.
+type workerPool[T any] struct {
+	size   int
+	policy QueueFullPolicy
+	queue  chan asyncTask[T]
+
+	startOnce sync.Once
+	run       func(asyncTask[T])
+	// discard receives the tasks dropped from the queue by QueueFullDropOldest
+	discard func(asyncTask[T])
+
+	stopOnce sync.Once
+	quit     chan struct{}
+}
+
+// newWorkerPool creates a worker pool from the options, or returns nil when
+// opts does not request one.
+func newWorkerPool[T any](opts *SignalOptions, run, discard func(asyncTask[T])) *workerPool[T] {
+	if opts == nil || opts.WorkerPoolSize <= 0 {
+		return nil
+	}
+	queueSize := defaultQueueSize
+	if opts.QueueSize > 0 {
+		queueSize = opts.QueueSize
+	}
+	return &workerPool[T]{
+		size:    opts.WorkerPoolSize,
+		policy:  opts.QueueFullPolicy,
+		queue:   make(chan asyncTask[T], queueSize),
+		run:     run,
+		discard: discard,
+		quit:    make(chan struct{}),
+	}
+}
+
+func (p *workerPool[T]) start() {
+	p.startOnce.Do(func() {
+		for i := 0; i < p.size; i++ {
+			go p.work()
+		}
+	})
+}
+
+func (p *workerPool[T]) work() {
+	for {
+		select {
+		case task := <-p.queue:
+			p.run(task)
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// stop makes the workers exit once they are idle. The queue must be empty and no
+// further tasks may be enqueued.
+func (p *workerPool[T]) stop() {
+	p.stopOnce.Do(func() {
+		close(p.quit)
+	})
+}
+
+// enqueue queues a task according to the pool's QueueFullPolicy. It returns
+// ErrQueueFull if the task was discarded, or ctx.Err() if the context ended while
+// blocked on a full queue.
+func (p *workerPool[T]) enqueue(ctx context.Context, task asyncTask[T]) error {
+	p.start()
+
+	select {
+	case p.queue <- task:
+		return nil
+	default:
+	}
+
+	switch p.policy {
+	case QueueFullDropNewest, QueueFullError:
+		return ErrQueueFull
+	case QueueFullDropOldest:
+		for {
+			select {
+			case p.queue <- task:
+				return nil
+			default:
+			}
+			select {
+			case dropped := <-p.queue:
+				p.discard(dropped)
+			default:
+			}
+		}
+	default:
+		var done <-chan struct{}
+		if ctx != nil {
+			done = ctx.Done()
+		}
+		select {
+		case p.queue <- task:
+			return nil
+		case <-done:
+			return ctx.Err()
+		}
+	}
+}
+
+// idleWorkers runs listener invocations for an AsyncSignal without a worker pool.
+// Every invocation still gets a goroutine of its own, but goroutines that have
+// finished a listener wait idleWorkerTimeout for the next one before exiting, so
+// that Emit in steady state reuses them instead of starting (and allocating) a new
+// goroutine per listener.
+type idleWorkers[T any] struct {
+	// tasks is unbuffered: a send only succeeds if a worker is idle
+	tasks chan asyncTask[T]
+	run   func(asyncTask[T])
+
+	// alive counts the running worker goroutines, busy or idle
+	alive atomic.Int64
+}
+
+func newIdleWorkers[T any](run func(asyncTask[T])) *idleWorkers[T] {
+	return &idleWorkers[T]{
+		tasks: make(chan asyncTask[T]),
+		run:   run,
+	}
+}
+
+// dispatch hands the task to an idle worker, or starts a new one if none is idle.
+func (w *idleWorkers[T]) dispatch(task asyncTask[T]) {
+	select {
+	case w.tasks <- task:
+		return
+	default:
+	}
+	if w.alive.Load() > 0 {
+		// Workers are still busy with earlier listeners. Yield once so that those
+		// that are about to finish can take the task before starting another one.
+		runtime.Gosched()
+		select {
+		case w.tasks <- task:
+			return
+		default:
+		}
+	}
+	w.alive.Add(1)
+	go w.work(task)
+}
+
+func (w *idleWorkers[T]) work(task asyncTask[T]) {
+	defer w.alive.Add(-1)
+	var timer *time.Timer
+	for {
+		w.run(task)
+		if timer == nil {
+			timer = time.NewTimer(idleWorkerTimeout)
+		} else {
+			timer.Reset(idleWorkerTimeout)
+		}
+		select {
+		case task = <-w.tasks:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-timer.C:
+			return
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+// newBlockedPoolSignal returns a signal with a single worker and a queue of one,
+// whose listener records payloads and blocks until release is closed. The first
+// emitted payload occupies the worker, the second fills the queue.
+func newBlockedPoolSignal(t *testing.T, policy signals.QueueFullPolicy) (sig *signals.AsyncSignal[int], got chan int, release chan struct{}) {
+	t.Helper()
+	sig = signals.NewWithOptions[int](&signals.SignalOptions{
+		WorkerPoolSize:  1,
+		QueueSize:       1,
+		QueueFullPolicy: policy,
+	})
+	got = make(chan int, 10)
+	release = make(chan struct{})
+	started := make(chan struct{}, 10)
+	sig.AddListener(func(ctx context.Context, v int) {
+		started <- struct{}{}
+		<-release
+		got <- v
+	})
+
+	if err := sig.TryEmit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected first emit to be accepted, got %v", err)
+	}
+	<-started
+	if err := sig.TryEmit(context.Background(), 2); err != nil {
+		t.Fatalf("Expected second emit to be queued, got %v", err)
+	}
+	return sig, got, release
+}
+
+func collect(t *testing.T, got chan int, n int) []int {
+	t.Helper()
+	values := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		select {
+		case v := <-got:
+			values = append(values, v)
+		case <-time.After(time.Second):
+			t.Fatalf("Expected %d deliveries, got %v", n, values)
+		}
+	}
+	return values
+}
+
+func TestAsyncSignal_WorkerPoolBoundsConcurrency(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 2})
+
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		sig.AddListener(func(ctx context.Context, v int) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			wg.Done()
+		})
+	}
+
+	wg.Add(30)
+	for i := 0; i < 3; i++ {
+		sig.Emit(context.Background(), i)
+	}
+	wg.Wait()
+
+	if m := atomic.LoadInt32(&maxInFlight); m > 2 {
+		t.Fatalf("Expected at most 2 concurrent listeners, saw %d", m)
+	}
+}
+
+func TestAsyncSignal_WorkerPoolReportsListenerErrors(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 1})
+
+	errBoom := errors.New("boom")
+	reported := make(chan string, 1)
+	sig.SetErrorHandler(func(err error, payload int, key string) {
+		if errors.Is(err, errBoom) {
+			reported <- key
+		}
+	})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return errBoom }, "k")
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case key := <-reported:
+		if key != "k" {
+			t.Fatalf("Expected key k, got %q", key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected error from pooled listener to be reported")
+	}
+}
+
+func TestAsyncSignal_WorkerPoolRecoversPanics(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 1})
+
+	done := make(chan struct{})
+	sig.AddListener(func(ctx context.Context, v int) {
+		if v == 1 {
+			panic("boom")
+		}
+		close(done)
+	})
+
+	sig.Emit(context.Background(), 1)
+	sig.Emit(context.Background(), 2)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected worker to survive a listener panic")
+	}
+}
+
+func TestAsyncSignal_QueueFullBlockWaitsForContext(t *testing.T) {
+	sig, got, release := newBlockedPoolSignal(t, signals.QueueFullBlock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := sig.TryEmit(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected DeadlineExceeded while blocked on a full queue, got %v", err)
+	}
+
+	close(release)
+	if values := collect(t, got, 2); values[0] != 1 || values[1] != 2 {
+		t.Fatalf("Expected deliveries [1 2], got %v", values)
+	}
+}
+
+func TestAsyncSignal_QueueFullBlockResumesWhenRoomAvailable(t *testing.T) {
+	sig, got, release := newBlockedPoolSignal(t, signals.QueueFullBlock)
+
+	emitted := make(chan struct{})
+	go func() {
+		sig.Emit(context.Background(), 3)
+		close(emitted)
+	}()
+
+	select {
+	case <-emitted:
+		t.Fatal("Expected Emit to block while the queue is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-emitted
+	if values := collect(t, got, 3); values[2] != 3 {
+		t.Fatalf("Expected blocked emit to be delivered last, got %v", values)
+	}
+}
+
+func TestAsyncSignal_QueueFullDropNewest(t *testing.T) {
+	sig, got, release := newBlockedPoolSignal(t, signals.QueueFullDropNewest)
+
+	if err := sig.TryEmit(context.Background(), 3); !errors.Is(err, signals.ErrQueueFull) {
+		t.Fatalf("Expected ErrQueueFull, got %v", err)
+	}
+	sig.Emit(context.Background(), 4)
+
+	close(release)
+	values := collect(t, got, 2)
+	if values[0] != 1 || values[1] != 2 {
+		t.Fatalf("Expected deliveries [1 2], got %v", values)
+	}
+	select {
+	case v := <-got:
+		t.Fatalf("Expected newest emits to be dropped, got delivery %d", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestAsyncSignal_QueueFullDropOldest(t *testing.T) {
+	sig, got, release := newBlockedPoolSignal(t, signals.QueueFullDropOldest)
+
+	if err := sig.TryEmit(context.Background(), 3); err != nil {
+		t.Fatalf("Expected nil error for drop-oldest, got %v", err)
+	}
+
+	close(release)
+	values := collect(t, got, 2)
+	if values[0] != 1 || values[1] != 3 {
+		t.Fatalf("Expected deliveries [1 3], got %v", values)
+	}
+}
+
+func TestAsyncSignal_QueueFullErrorReportsToHandler(t *testing.T) {
+	sig, got, release := newBlockedPoolSignal(t, signals.QueueFullError)
+
+	reported := make(chan int, 1)
+	sig.SetErrorHandler(func(err error, payload int, key string) {
+		if errors.Is(err, signals.ErrQueueFull) {
+			reported <- payload
+		}
+	})
+
+	sig.Emit(context.Background(), 3)
+	select {
+	case v := <-reported:
+		if v != 3 {
+			t.Fatalf("Expected payload 3 to be reported, got %d", v)
+		}
+	default:
+		t.Fatal("Expected Emit to report ErrQueueFull to the error handler")
+	}
+
+	if err := sig.TryEmit(context.Background(), 4); !errors.Is(err, signals.ErrQueueFull) {
+		t.Fatalf("Expected ErrQueueFull from TryEmit, got %v", err)
+	}
+
+	close(release)
+	collect(t, got, 2)
+}
+
+func TestAsyncSignal_EmitAndWaitUsesWorkerPool(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 2})
+
+	errA := errors.New("a")
+	var calls int32
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		atomic.AddInt32(&calls, 1)
+		return errA
+	})
+	for i := 0; i < 5; i++ {
+		sig.AddListener(func(ctx context.Context, v int) { atomic.AddInt32(&calls, 1) })
+	}
+
+	err := sig.EmitAndWait(context.Background(), 1)
+	if !errors.Is(err, errA) {
+		t.Fatalf("Expected listener error, got %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 6 {
+		t.Fatalf("Expected 6 listener calls, got %d", n)
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitReportsDroppedTasks(t *testing.T) {
+	sig, got, release := newBlockedPoolSignal(t, signals.QueueFullDropNewest)
+
+	err := sig.EmitAndWait(context.Background(), 3)
+	if !errors.Is(err, signals.ErrQueueFull) {
+		t.Fatalf("Expected ErrQueueFull from EmitAndWait, got %v", err)
+	}
+
+	close(release)
+	collect(t, got, 2)
+}
+
+func TestAsyncSignal_TryEmitWithoutPool(t *testing.T) {
+	sig := signals.New[int]()
+
+	done := make(chan struct{})
+	sig.AddListener(func(ctx context.Context, v int) { close(done) })
+
+	if err := sig.TryEmit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	<-done
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sig.TryEmit(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+}
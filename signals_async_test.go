@@ -10,11 +10,10 @@ import (
 	"github.com/maniartech/signals"
 )
 
-// Test AsyncSignal only supports regular listeners, not error-returning ones
+// Test AsyncSignal accepts regular listeners
 func TestAsyncSignal_OnlySupportsRegularListeners(t *testing.T) {
 	sig := signals.New[int]()
 
-	// AsyncSignal should only support regular listeners
 	count := sig.AddListener(func(ctx context.Context, v int) {
 		// Regular listener
 	})
@@ -22,9 +21,6 @@ func TestAsyncSignal_OnlySupportsRegularListeners(t *testing.T) {
 	if count != 1 {
 		t.Errorf("Expected count 1, got %d", count)
 	}
-
-	// Note: AddListenerWithErr is not available on AsyncSignal
-	// This is by design - error handling is only for SyncSignal
 } // Test AsyncSignal with large number of listeners (>16) to trigger pooled worker path
 func TestAsyncSignal_LargeListenerCount(t *testing.T) {
 	sig := signals.New[int]()
@@ -78,11 +74,10 @@ func TestAsyncSignal_NilListenerFastPath(t *testing.T) {
 	// Should complete without issues
 }
 
-// Test AsyncSignal design principle: no error listener support
+// Test AsyncSignal emits to regular listeners without an error handler
 func TestAsyncSignal_NoErrorListenerSupport(t *testing.T) {
 	sig := signals.New[int]()
 
-	// AsyncSignal only supports regular listeners
 	count := sig.AddListener(func(ctx context.Context, v int) {
 		// Process async
 	})
@@ -91,8 +86,6 @@ func TestAsyncSignal_NoErrorListenerSupport(t *testing.T) {
 		t.Errorf("Expected count 1, got %d", count)
 	}
 
-	// Note: AsyncSignal.Emit only processes regular listeners
-	// Error listeners and TryEmit are exclusive to SyncSignal
 	sig.Emit(context.Background(), 1)
 } // Test AsyncSignal ensureWorkerPool edge cases
 func TestAsyncSignal_EnsureWorkerPoolEdgeCases(t *testing.T) {
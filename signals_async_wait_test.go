@@ -0,0 +1,136 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestAsyncSignal_EmitAndWaitWaitsForAllListeners(t *testing.T) {
+	sig := signals.New[int]()
+
+	var finished int32
+	for i := 0; i < 5; i++ {
+		sig.AddListener(func(ctx context.Context, v int) {
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&finished, 1)
+		})
+	}
+
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	if got := atomic.LoadInt32(&finished); got != 5 {
+		t.Fatalf("Expected all 5 listeners to finish before return, got %d", got)
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitRunsListenersConcurrently(t *testing.T) {
+	sig := signals.New[int]()
+
+	for i := 0; i < 10; i++ {
+		sig.AddListener(func(ctx context.Context, v int) {
+			time.Sleep(50 * time.Millisecond)
+		})
+	}
+
+	start := time.Now()
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 400*time.Millisecond {
+		t.Fatalf("Expected listeners to run concurrently, took %v", elapsed)
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitJoinsErrors(t *testing.T) {
+	sig := signals.New[int]()
+
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return errA }, "a")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return nil }, "ok")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return errB }, "b")
+
+	err := sig.EmitAndWait(context.Background(), 1)
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("Expected joined error containing both failures, got %v", err)
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitRecoversPanics(t *testing.T) {
+	sig := signals.New[int]()
+
+	var ran int32
+	sig.AddListener(func(ctx context.Context, v int) {
+		panic("kaboom")
+	}, "panicky")
+	sig.AddListener(func(ctx context.Context, v int) {
+		atomic.AddInt32(&ran, 1)
+	})
+
+	err := sig.EmitAndWait(context.Background(), 1)
+	if err == nil || !strings.Contains(err.Error(), "kaboom") || !strings.Contains(err.Error(), "panicky") {
+		t.Fatalf("Expected panic reported as error, got %v", err)
+	}
+	if atomic.LoadInt32(&ran) != 1 {
+		t.Fatal("Expected the other listener to run despite the panic")
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitContextDeadline(t *testing.T) {
+	sig := signals.New[int]()
+
+	release := make(chan struct{})
+	defer close(release)
+	errFast := errors.New("fast failed")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return errFast })
+	sig.AddListener(func(ctx context.Context, v int) {
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := sig.EmitAndWait(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected DeadlineExceeded, got %v", err)
+	}
+	if !errors.Is(err, errFast) {
+		t.Fatalf("Expected errors collected before the deadline to be included, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatal("Expected EmitAndWait to return at the context deadline")
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitCanceledContext(t *testing.T) {
+	sig := signals.New[int]()
+
+	called := int32(0)
+	sig.AddListener(func(ctx context.Context, v int) { atomic.AddInt32(&called, 1) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := sig.EmitAndWait(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if atomic.LoadInt32(&called) != 0 {
+		t.Fatal("Expected no listeners to run with a canceled context")
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitNoListeners(t *testing.T) {
+	sig := signals.New[int]()
+
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error with no listeners, got %v", err)
+	}
+}
@@ -66,3 +66,82 @@ func BenchmarkSignalAddRemoveListener_Concurrent(b *testing.B) {
 		}
 	})
 }
+
+// Benchmark concurrent synchronous emission; every emitter reads the subscriber
+// snapshot, so this measures contention on the emit hot path
+func BenchmarkSyncSignalEmit_Parallel(b *testing.B) {
+	signal := signals.NewSync[int]()
+	for i := 0; i < 8; i++ {
+		signal.AddListener(func(ctx context.Context, v int) {})
+	}
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			signal.Emit(ctx, i)
+		}
+	})
+}
+
+// Benchmark concurrent synchronous emission with many listeners
+func BenchmarkSyncSignalEmit_ParallelManyListeners(b *testing.B) {
+	signal := signals.NewSync[int]()
+	for i := 0; i < 100; i++ {
+		signal.AddListener(func(ctx context.Context, v int) {})
+	}
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			signal.Emit(ctx, i)
+		}
+	})
+}
+
+// Benchmark concurrent synchronous emission while listeners are added and removed
+func BenchmarkSyncSignalEmit_ParallelWithChurn(b *testing.B) {
+	signal := signals.NewSync[int]()
+	for i := 0; i < 8; i++ {
+		signal.AddListener(func(ctx context.Context, v int) {})
+	}
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			signal.AddListener(func(ctx context.Context, v int) {}, "churn")
+			signal.RemoveListener("churn")
+		}
+	}()
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			signal.Emit(ctx, i)
+		}
+	})
+	b.StopTimer()
+	close(stop)
+	<-done
+}
+
+// Benchmark concurrent Len calls, which read the subscriber snapshot
+func BenchmarkSignalLen_Parallel(b *testing.B) {
+	signal := signals.NewSync[int]()
+	signal.AddListener(func(ctx context.Context, v int) {})
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = signal.Len()
+		}
+	})
+}
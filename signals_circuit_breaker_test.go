@@ -0,0 +1,252 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+var errDownstream = errors.New("downstream unavailable")
+
+// stateRecorder collects circuit state changes.
+type stateRecorder struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (r *stateRecorder) record(key string, from, to signals.CircuitState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, fmt.Sprintf("%s:%s->%s", key, from, to))
+}
+
+func (r *stateRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.changes...)
+}
+
+// breakerListener registers an error listener whose result is controlled by failing,
+// counting its calls.
+func breakerListener(sig *signals.SyncSignal[int], failing *atomic.Bool, calls *atomic.Int32, breaker *signals.CircuitBreaker) {
+	sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error {
+		calls.Add(1)
+		if failing.Load() {
+			return errDownstream
+		}
+		return nil
+	}, signals.ListenerOptions{Key: "downstream", CircuitBreaker: breaker})
+}
+
+func TestSyncSignal_CircuitOpensAfterThreshold(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	rec := &stateRecorder{}
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{
+		FailureThreshold: 2,
+		OpenDuration:     time.Hour,
+		OnStateChange:    rec.record,
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errDownstream) {
+			t.Fatalf("Expected the listener error on attempt %d, got %v", i+1, err)
+		}
+	}
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, signals.ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("Expected the open listener to be skipped, got %d calls", calls.Load())
+	}
+	if !equalOrder(rec.get(), []string{"downstream:closed->open"}) {
+		t.Fatalf("Unexpected state changes %v", rec.get())
+	}
+}
+
+func TestSyncSignal_CircuitSuccessResetsFailures(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{FailureThreshold: 2, OpenDuration: time.Hour})
+
+	for _, fail := range []bool{true, false, true, false, true} {
+		failing.Store(fail)
+		_ = sig.TryEmit(context.Background(), 1)
+	}
+	if calls.Load() != 5 {
+		t.Fatalf("Expected non-consecutive failures to keep the circuit closed, got %d calls", calls.Load())
+	}
+}
+
+func TestSyncSignal_CircuitEmitSkipsOpenListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Hour})
+	var others int
+	sig.AddListener(func(ctx context.Context, v int) { others++ })
+
+	for i := 0; i < 3; i++ {
+		sig.Emit(context.Background(), 1)
+	}
+	if calls.Load() != 1 || others != 3 {
+		t.Fatalf("Expected 1 call of the open listener and 3 of the others, got %d and %d", calls.Load(), others)
+	}
+}
+
+func TestSyncSignal_CircuitHalfOpenCloses(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	rec := &stateRecorder{}
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{
+		FailureThreshold: 1,
+		OpenDuration:     10 * time.Millisecond,
+		HalfOpenProbes:   2,
+		OnStateChange:    rec.record,
+	})
+
+	_ = sig.TryEmit(context.Background(), 1)
+	time.Sleep(20 * time.Millisecond)
+	failing.Store(false)
+	for i := 0; i < 2; i++ {
+		if err := sig.TryEmit(context.Background(), 1); err != nil {
+			t.Fatalf("Expected probe %d to succeed, got %v", i+1, err)
+		}
+	}
+
+	want := []string{"downstream:closed->open", "downstream:open->half-open", "downstream:half-open->closed"}
+	if !equalOrder(rec.get(), want) {
+		t.Fatalf("Expected state changes %v, got %v", want, rec.get())
+	}
+}
+
+func TestSyncSignal_CircuitHalfOpenFailureReopens(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	rec := &stateRecorder{}
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{
+		FailureThreshold: 1,
+		OpenDuration:     10 * time.Millisecond,
+		OnStateChange:    rec.record,
+	})
+
+	_ = sig.TryEmit(context.Background(), 1)
+	time.Sleep(20 * time.Millisecond)
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errDownstream) {
+		t.Fatalf("Expected the probe to fail, got %v", err)
+	}
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, signals.ErrCircuitOpen) {
+		t.Fatalf("Expected the circuit to be open again, got %v", err)
+	}
+
+	want := []string{"downstream:closed->open", "downstream:open->half-open", "downstream:half-open->open"}
+	if !equalOrder(rec.get(), want) {
+		t.Fatalf("Expected state changes %v, got %v", want, rec.get())
+	}
+}
+
+func TestSyncSignal_CircuitTryEmitAll(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Hour})
+	_ = sig.TryEmit(context.Background(), 1)
+
+	err := sig.TryEmitAll(context.Background(), 1)
+	var lerr *signals.ListenerError
+	if !errors.As(err, &lerr) || lerr.Key != "downstream" || !errors.Is(err, signals.ErrCircuitOpen) {
+		t.Fatalf("Expected a ListenerError wrapping ErrCircuitOpen, got %v", err)
+	}
+}
+
+func TestAsyncSignal_CircuitReportsOpen(t *testing.T) {
+	sig := signals.New[int]()
+	errs := make(chan error, 4)
+	sig.SetErrorHandler(func(err error, payload int, key string) { errs <- err })
+	sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error {
+		return errDownstream
+	}, signals.ListenerOptions{
+		Key:            "downstream",
+		CircuitBreaker: &signals.CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Hour},
+	})
+
+	if err := sig.EmitAndWait(context.Background(), 1); !errors.Is(err, errDownstream) {
+		t.Fatalf("Expected the listener error, got %v", err)
+	}
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, signals.ErrCircuitOpen) {
+			t.Fatalf("Expected ErrCircuitOpen, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected ErrCircuitOpen to reach the error handler")
+	}
+}
+
+func TestCircuitState_String(t *testing.T) {
+	for state, want := range map[signals.CircuitState]string{
+		signals.CircuitClosed:   "closed",
+		signals.CircuitOpen:     "open",
+		signals.CircuitHalfOpen: "half-open",
+		signals.CircuitState(9): "unknown",
+	} {
+		if got := state.String(); got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
+
+func TestSyncSignal_CircuitOpenDoesNotStopTryEmit(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Hour})
+	var next int
+	sig.AddListener(func(ctx context.Context, v int) { next++ })
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errDownstream) {
+		t.Fatalf("Expected the listener error, got %v", err)
+	}
+	err := sig.TryEmit(context.Background(), 1)
+	var cerr *signals.CircuitOpenError
+	if !errors.As(err, &cerr) || cerr.Key != "downstream" || !errors.Is(err, signals.ErrCircuitOpen) {
+		t.Fatalf("Expected *CircuitOpenError for the skipped listener, got %v", err)
+	}
+	if next != 1 {
+		t.Fatalf("Expected the listener after the open one to run once, ran %d times", next)
+	}
+}
+
+func TestSyncSignal_CircuitOpenReportsLaterFailure(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var failing atomic.Bool
+	var calls atomic.Int32
+	failing.Store(true)
+	breakerListener(sig, &failing, &calls, &signals.CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Hour})
+	_ = sig.TryEmit(context.Background(), 1)
+
+	later := errors.New("later failure")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return later })
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, later) {
+		t.Fatalf("Expected the later listener error to take precedence, got %v", err)
+	}
+}
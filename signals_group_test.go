@@ -0,0 +1,114 @@
+package signals_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestGroup_CloseRemovesAcrossSignals(t *testing.T) {
+	ints := signals.NewSync[int]()
+	strs := signals.New[string]()
+
+	var g signals.Group
+	g.Add(
+		ints.Subscribe(func(ctx context.Context, v int) {}),
+		ints.SubscribeWithErr(func(ctx context.Context, v int) error { return nil }, "k"),
+		strs.Subscribe(func(ctx context.Context, v string) {}),
+	)
+	ints.AddListener(func(ctx context.Context, v int) {}, "outside")
+
+	if n := g.Len(); n != 3 {
+		t.Fatalf("Expected 3 subscriptions, got %d", n)
+	}
+	if n := g.Close(); n != 3 {
+		t.Fatalf("Expected Close to remove 3 listeners, got %d", n)
+	}
+	if ints.Len() != 1 || !strs.IsEmpty() {
+		t.Fatalf("Expected only the outside listener to remain, got ints=%d strs=%d", ints.Len(), strs.Len())
+	}
+	if n := g.Close(); n != 0 {
+		t.Fatalf("Expected second Close to remove nothing, got %d", n)
+	}
+}
+
+func TestGroup_CountsOnlyRemovedByClose(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var g signals.Group
+	g.Add(
+		sig.Subscribe(func(ctx context.Context, v int) {}, "a"),
+		sig.Subscribe(func(ctx context.Context, v int) {}, "b"),
+		sig.Subscribe(func(ctx context.Context, v int) {}, "a"), // duplicate: nil
+	)
+	sig.RemoveListener("a")
+
+	if n := g.Close(); n != 1 {
+		t.Fatalf("Expected Close to remove 1 listener, got %d", n)
+	}
+}
+
+func TestGroup_AddAfterClose(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var g signals.Group
+	g.Close()
+	sub := sig.Subscribe(func(ctx context.Context, v int) {})
+	g.Add(sub)
+
+	if sub.Active() || !sig.IsEmpty() {
+		t.Fatal("Expected subscription added after Close to be removed")
+	}
+}
+
+func TestGroup_DropsInactiveSubscriptions(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var g signals.Group
+	for i := 0; i < 100; i++ {
+		sub := sig.Subscribe(func(ctx context.Context, v int) {})
+		g.Add(sub)
+		sub.Unsubscribe()
+	}
+	g.Add(sig.Subscribe(func(ctx context.Context, v int) {}))
+
+	if n := g.Len(); n != 1 {
+		t.Fatalf("Expected 1 active subscription, got %d", n)
+	}
+	if n := g.Close(); n != 1 {
+		t.Fatalf("Expected Close to remove 1 listener, got %d", n)
+	}
+}
+
+func TestGroup_CloseConcurrentWithEmit(t *testing.T) {
+	sig := signals.New[int]()
+
+	var g signals.Group
+	for i := 0; i < 50; i++ {
+		g.Add(sig.Subscribe(func(ctx context.Context, v int) {}))
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				sig.Emit(context.Background(), j)
+				g.Add(sig.Subscribe(func(ctx context.Context, v int) {}))
+			}
+		}()
+	}
+	removed := g.Close()
+	wg.Wait()
+
+	if removed < 50 {
+		t.Fatalf("Expected at least 50 listeners removed, got %d", removed)
+	}
+	// Subscriptions added after Close are removed by Add
+	if !sig.IsEmpty() {
+		t.Fatalf("Expected no listeners, got %d", sig.Len())
+	}
+}
@@ -0,0 +1,156 @@
+package signals_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+// hookRecorder records lifecycle hook calls.
+type hookRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *hookRecorder) record(event string) func() {
+	return func() {
+		r.mu.Lock()
+		r.events = append(r.events, event)
+		r.mu.Unlock()
+	}
+}
+
+func (r *hookRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func TestSyncSignal_LifecycleHooks(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &hookRecorder{}
+	sig.OnFirstListener(r.record("first"))
+	sig.OnLastListenerRemoved(r.record("last"))
+
+	sig.AddListener(func(ctx context.Context, v int) {}, "a")
+	sig.AddListener(func(ctx context.Context, v int) {}, "b")
+	sig.AddListener(func(ctx context.Context, v int) {}, "a") // duplicate
+	sig.RemoveListener("a")
+	sig.RemoveListener("missing")
+	sig.RemoveListener("b")
+	sig.AddListener(func(ctx context.Context, v int) {})
+	sig.Reset()
+	sig.Reset()
+
+	want := []string{"first", "last", "first", "last"}
+	if got := r.get(); !equalOrder(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_LifecycleHooksOtherRemovals(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &hookRecorder{}
+	sig.OnFirstListener(r.record("first"))
+	sig.OnLastListenerRemoved(r.record("last"))
+
+	sig.AddOnceListener(func(ctx context.Context, v int) {})
+	sig.Emit(context.Background(), 1)
+
+	sub := sig.Subscribe(func(ctx context.Context, v int) {})
+	sub.Unsubscribe()
+
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{Tags: []string{"x"}})
+	sig.RemoveListenersByTag("x")
+
+	want := []string{"first", "last", "first", "last", "first", "last"}
+	if got := r.get(); !equalOrder(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_LifecycleHookMayChangeListeners(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &hookRecorder{}
+	record := r.record("last")
+	sig.OnFirstListener(func() {
+		// Runs outside the signal lock
+		sig.AddListener(func(ctx context.Context, v int) {}, "producer")
+	})
+	sig.OnLastListenerRemoved(record)
+
+	sig.AddListener(func(ctx context.Context, v int) {}, "consumer")
+	if !sig.Has("producer") || sig.Len() != 2 {
+		t.Fatalf("Expected hook to add a listener, got keys %v", sig.Keys())
+	}
+	sig.Reset()
+	if got := r.get(); !equalOrder(got, []string{"last"}) {
+		t.Fatalf("Expected [last], got %v", got)
+	}
+}
+
+func TestSyncSignal_LifecycleHookNotCalledForExistingListeners(t *testing.T) {
+	sig := signals.NewSync[int]()
+	sig.AddListener(func(ctx context.Context, v int) {}, "a")
+
+	r := &hookRecorder{}
+	sig.OnFirstListener(r.record("first"))
+	sig.AddListener(func(ctx context.Context, v int) {}, "b")
+	sig.OnFirstListener(nil)
+	sig.Reset()
+	sig.AddListener(func(ctx context.Context, v int) {}, "c")
+
+	if got := r.get(); len(got) != 0 {
+		t.Fatalf("Expected no hook calls, got %v", got)
+	}
+}
+
+func TestAsyncSignal_LifecycleHooksSerialized(t *testing.T) {
+	sig := signals.New[int]()
+
+	var mu sync.Mutex
+	running := false
+	balance := 0
+	check := func(delta int) func() {
+		return func() {
+			mu.Lock()
+			if running {
+				t.Error("Expected hooks not to run concurrently")
+			}
+			running = true
+			mu.Unlock()
+			time.Sleep(time.Microsecond)
+			mu.Lock()
+			running = false
+			balance += delta
+			if balance < 0 || balance > 1 {
+				t.Errorf("Expected hooks to alternate, balance %d", balance)
+			}
+			mu.Unlock()
+		}
+	}
+	sig.OnFirstListener(check(1))
+	sig.OnLastListenerRemoved(check(-1))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				sub := sig.Subscribe(func(ctx context.Context, v int) {})
+				sub.Unsubscribe()
+			}
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if balance != 0 {
+		t.Fatalf("Expected every first hook to be matched by a last hook, balance %d", balance)
+	}
+}
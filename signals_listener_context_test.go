@@ -0,0 +1,108 @@
+package signals_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+// waitFor polls cond until it holds or the timeout expires.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSyncSignal_AddListenerContextRemovedOnCancel(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	sub := sig.AddListenerContext(ctx, func(ctx context.Context, v int) { calls++ }, "conn")
+
+	sig.Emit(context.Background(), 1)
+	cancel()
+	waitFor(t, func() bool { return sig.IsEmpty() })
+	sig.Emit(context.Background(), 2)
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls)
+	}
+	if sub.Active() {
+		t.Fatal("Expected subscription to be inactive after cancel")
+	}
+	if n := sig.AddListener(func(ctx context.Context, v int) {}, "conn"); n != 1 {
+		t.Fatalf("Expected key to be free after cancel, got %d", n)
+	}
+}
+
+func TestAsyncSignal_AddListenerWithErrContextRemovedOnDeadline(t *testing.T) {
+	sig := signals.New[int]()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	sig.AddListenerWithErrContext(ctx, func(ctx context.Context, v int) error { return nil })
+	if sig.Len() != 1 {
+		t.Fatalf("Expected 1 listener, got %d", sig.Len())
+	}
+
+	waitFor(t, func() bool { return sig.IsEmpty() })
+}
+
+func TestAddListenerContext_DoneContextNotRegistered(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if sub := sig.AddListenerContext(ctx, func(ctx context.Context, v int) {}); sub != nil {
+		t.Fatal("Expected nil subscription for a done context")
+	}
+	if !sig.IsEmpty() {
+		t.Fatalf("Expected no listeners, got %d", sig.Len())
+	}
+}
+
+func TestAddListenerContext_EarlyRemoval(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub := sig.AddListenerContext(ctx, func(ctx context.Context, v int) {}, "k")
+	sig.RemoveListener("k")
+	// Re-registering the key must not be undone by the first listener's context
+	sig.AddListener(func(ctx context.Context, v int) {}, "k")
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+
+	if sub.Active() {
+		t.Fatal("Expected subscription to be inactive")
+	}
+	if sig.Len() != 1 {
+		t.Fatalf("Expected the new listener to stay registered, got %d listeners", sig.Len())
+	}
+}
+
+func TestAddListenerContext_NoGoroutinePerListener(t *testing.T) {
+	sig := signals.New[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	base := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		sig.AddListenerContext(ctx, func(ctx context.Context, v int) {})
+	}
+	if after := runtime.NumGoroutine(); after > base+5 {
+		t.Fatalf("Expected no goroutine per listener; baseline=%d after=%d", base, after)
+	}
+
+	cancel()
+	waitFor(t, func() bool { return sig.IsEmpty() })
+}
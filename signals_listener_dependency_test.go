@@ -0,0 +1,249 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func addOrdered(t *testing.T, sig *signals.SyncSignal[int], r *orderRecorder, opts signals.ListenerOptions) {
+	t.Helper()
+	if _, err := sig.AddListenerWithOptions(r.listener(opts.Key), opts); err != nil {
+		t.Fatalf("Unexpected error adding %q: %v", opts.Key, err)
+	}
+}
+
+func TestSyncSignal_AfterConstraint(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "inventory-reserve", After: []string{"payment-authorize"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "payment-authorize"})
+
+	want := []string{"payment-authorize", "inventory-reserve"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_BeforeConstraint(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "persist"})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "notify"})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "validate", Before: []string{"persist"}})
+
+	want := []string{"validate", "persist", "notify"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_ConstraintsOverridePriority(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "audit", Priority: 100, After: []string{"persist"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "persist"})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "auth", Priority: 50})
+	sig.AddListener(r.listener("plain"), "plain")
+
+	// audit wants to run first but must wait for persist, which is pulled forward.
+	want := []string{"persist", "audit", "auth", "plain"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_ConstraintChain(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "ship", After: []string{"reserve"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "reserve", After: []string{"authorize"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "authorize"})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "email", Before: []string{"ship"}, After: []string{"authorize"}})
+
+	want := []string{"authorize", "reserve", "email", "ship"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_RemovalResortsListeners(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "c", After: []string{"x"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "x", After: []string{"b"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "b"})
+
+	want := []string{"b", "x", "c"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+
+	sig.RemoveListener("x")
+
+	want = []string{"c", "b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected registration order %v once the link is removed, got %v", want, got)
+	}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "x", Before: []string{"c"}, After: []string{"b"}})
+
+	want = []string{"b", "x", "c"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v after re-adding, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_ConstraintCycleRejected(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "a", After: []string{"c"}})
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "b", After: []string{"a"}})
+
+	n, err := sig.AddListenerWithOptions(r.listener("c"), signals.ListenerOptions{Key: "c", After: []string{"b"}})
+	if n != -1 {
+		t.Fatalf("Expected -1 for rejected listener, got %d", n)
+	}
+	var cycle *signals.OrderCycleError
+	if !errors.As(err, &cycle) {
+		t.Fatalf("Expected *OrderCycleError, got %v", err)
+	}
+	if len(cycle.Keys) != 3 || cycle.Keys[0] != "c" {
+		t.Fatalf("Expected a 3-listener cycle starting with c, got %v", cycle.Keys)
+	}
+	for _, k := range []string{"a", "b", "c"} {
+		if !strings.Contains(err.Error(), k) {
+			t.Fatalf("Expected error to name %q, got %q", k, err.Error())
+		}
+	}
+
+	if sig.Len() != 2 {
+		t.Fatalf("Expected rejected listener not to be added, got %d listeners", sig.Len())
+	}
+	want := []string{"a", "b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+
+	// The key of the rejected listener is still free.
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "c"})
+	want = []string{"c", "a", "b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_CycleErrorMessage(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	sig.AddListener(func(ctx context.Context, v int) {}, "payment")
+	_, err := sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{
+		Key:    "inventory",
+		After:  []string{"payment"},
+		Before: []string{"payment"},
+	})
+
+	want := "signals: listener ordering cycle: inventory -> payment -> inventory"
+	if err == nil || err.Error() != want {
+		t.Fatalf("Expected %q, got %v", want, err)
+	}
+}
+
+func TestSyncSignal_SelfConstraintRejected(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	_, err := sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{
+		Key:   "self",
+		After: []string{"self"},
+	})
+	var cycle *signals.OrderCycleError
+	if !errors.As(err, &cycle) || len(cycle.Keys) != 1 || cycle.Keys[0] != "self" {
+		t.Fatalf("Expected self cycle error, got %v", err)
+	}
+	if !sig.IsEmpty() {
+		t.Fatal("Expected rejected listener not to be added")
+	}
+}
+
+func TestSyncSignal_UnkeyedListenerInCycle(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{Key: "b", Before: []string{"a"}})
+	sig.AddListener(func(ctx context.Context, v int) {}, "a")
+
+	_, err := sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{
+		After:  []string{"a"},
+		Before: []string{"b"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "(unkeyed)") {
+		t.Fatalf("Expected cycle error naming the unkeyed listener, got %v", err)
+	}
+}
+
+func TestSyncSignal_AddListenerWithOptionsDuplicateKey(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	sig.AddListener(func(ctx context.Context, v int) {}, "k")
+	n, err := sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error { return nil }, signals.ListenerOptions{Key: "k"})
+	if n != -1 || !errors.Is(err, signals.ErrDuplicateKey) {
+		t.Fatalf("Expected -1 and ErrDuplicateKey, got %d, %v", n, err)
+	}
+}
+
+func TestSyncSignal_AddListenerWithOptionsZeroValue(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	sig.AddListener(r.listener("first"))
+	if n, err := sig.AddListenerWithOptions(r.listener("second"), signals.ListenerOptions{}); n != 2 || err != nil {
+		t.Fatalf("Expected 2, nil; got %d, %v", n, err)
+	}
+
+	want := []string{"first", "second"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_ResetClearsConstraints(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	addOrdered(t, sig, r, signals.ListenerOptions{Key: "a", After: []string{"b"}})
+	sig.Reset()
+
+	sig.AddListener(r.listener("a"), "a")
+	sig.AddListener(r.listener("b"), "b")
+
+	want := []string{"a", "b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestAsyncSignal_DependencyOrdersDispatch(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 1})
+
+	got := make(chan string, 2)
+	sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error {
+		got <- "reserve"
+		return nil
+	}, signals.ListenerOptions{Key: "reserve", After: []string{"authorize"}})
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) { got <- "authorize" }, signals.ListenerOptions{Key: "authorize"})
+
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	if first := <-got; first != "authorize" {
+		t.Fatalf("Expected authorize to be dispatched first, got %s", first)
+	}
+}
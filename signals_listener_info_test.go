@@ -0,0 +1,105 @@
+package signals_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSignal_KeysAndHas(t *testing.T) {
+	sig := signals.NewSync[int]()
+	sig.AddListener(func(ctx context.Context, v int) {}, "persist")
+	sig.AddListener(func(ctx context.Context, v int) {})
+	sig.AddListenerWithPriority(func(ctx context.Context, v int) {}, 10, "validate")
+
+	if keys := sig.Keys(); !equalOrder(keys, []string{"validate", "persist"}) {
+		t.Fatalf("Expected keys [validate persist], got %v", keys)
+	}
+	if !sig.Has("persist") || sig.Has("audit") {
+		t.Fatal("Expected Has to report registered keys only")
+	}
+
+	sig.RemoveListener("persist")
+	if sig.Has("persist") {
+		t.Fatal("Expected Has to be false after RemoveListener")
+	}
+
+	var zero signals.AsyncSignal[int]
+	if len(zero.Keys()) != 0 || zero.Has("x") || len(zero.Listeners()) != 0 {
+		t.Fatal("Expected zero value signal to have no listeners")
+	}
+}
+
+func TestSignal_ListenersMetadata(t *testing.T) {
+	sig := signals.New[int]()
+
+	before := time.Now()
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{
+		Key:         "audit",
+		Priority:    5,
+		Description: "writes the audit log",
+		Owner:       "audit-team",
+		Tags:        []string{"audit", "compliance"},
+	})
+	sig.AddOnceListener(func(ctx context.Context, v int) {})
+
+	infos := sig.Listeners()
+	if len(infos) != 2 {
+		t.Fatalf("Expected 2 listeners, got %d", len(infos))
+	}
+
+	audit := infos[0]
+	if audit.Key != "audit" || audit.Priority != 5 || audit.Once {
+		t.Fatalf("Unexpected listener info: %+v", audit)
+	}
+	if audit.Description != "writes the audit log" || audit.Owner != "audit-team" {
+		t.Fatalf("Unexpected metadata: %+v", audit)
+	}
+	if !equalOrder(audit.Tags, []string{"audit", "compliance"}) {
+		t.Fatalf("Expected tags [audit compliance], got %v", audit.Tags)
+	}
+	if audit.RegisteredAt.Before(before) || audit.RegisteredAt.After(time.Now()) {
+		t.Fatalf("Unexpected registration time %v", audit.RegisteredAt)
+	}
+	if !strings.Contains(audit.CallSite, "signals_listener_info_test.go:") {
+		t.Fatalf("Expected call site in this file, got %q", audit.CallSite)
+	}
+
+	once := infos[1]
+	if once.Key != "" || !once.Once {
+		t.Fatalf("Unexpected listener info: %+v", once)
+	}
+	if once.Owner != "github.com/maniartech/signals_test" {
+		t.Fatalf("Expected owner to default to the registering package, got %q", once.Owner)
+	}
+	if !strings.Contains(once.CallSite, "signals_listener_info_test.go:") {
+		t.Fatalf("Expected call site in this file, got %q", once.CallSite)
+	}
+}
+
+func TestSignal_ListenersIsSnapshot(t *testing.T) {
+	sig := signals.NewSync[int]()
+	tags := []string{"a"}
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {}, signals.ListenerOptions{Key: "k", Tags: tags})
+	tags[0] = "changed"
+
+	infos := sig.Listeners()
+	if infos[0].Tags[0] != "a" {
+		t.Fatalf("Expected tags to be copied on registration, got %v", infos[0].Tags)
+	}
+	infos[0].Tags[0] = "mutated"
+	if got := sig.Listeners()[0].Tags[0]; got != "a" {
+		t.Fatalf("Expected Listeners to return copies, got %q", got)
+	}
+
+	sig.Reset()
+	if got := sig.Listeners(); len(got) != 0 {
+		t.Fatalf("Expected no listeners after Reset, got %d", len(got))
+	}
+	if len(infos) != 1 || infos[0].Key != "k" {
+		t.Fatalf("Expected earlier snapshot to be unaffected, got %+v", infos)
+	}
+}
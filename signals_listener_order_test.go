@@ -37,3 +37,126 @@ func TestSyncSignal_OrderPreservedAfterRemoval(t *testing.T) {
 		t.Fatalf("Expected listener order [1 3] after removal, got %v", order)
 	}
 }
+
+// orderRecorder registers keyed listeners that append their key to order on emit.
+type orderRecorder struct {
+	order []string
+}
+
+func (r *orderRecorder) listener(key string) signals.SignalListener[int] {
+	return func(ctx context.Context, v int) { r.order = append(r.order, key) }
+}
+
+func (r *orderRecorder) emit(sig *signals.SyncSignal[int]) []string {
+	r.order = r.order[:0]
+	sig.Emit(context.Background(), 1)
+	return append([]string(nil), r.order...)
+}
+
+func equalOrder(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSyncSignal_OrderPreservedAfterRemovingFirst(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	for _, k := range []string{"validate", "enrich", "persist", "notify"} {
+		sig.AddListener(r.listener(k), k)
+	}
+
+	sig.RemoveListener("validate")
+
+	want := []string{"enrich", "persist", "notify"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_OrderPreservedAcrossChurn(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		sig.AddListener(r.listener(k), k)
+	}
+
+	sig.RemoveListener("b")
+	sig.RemoveListener("a")
+	sig.AddListener(r.listener("f"), "f")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		r.order = append(r.order, "g")
+		return nil
+	}, "g")
+	sig.RemoveListener("e")
+	sig.AddListener(r.listener("b"), "b")
+
+	want := []string{"c", "d", "f", "g", "b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+
+	sig.Reset()
+	for _, k := range []string{"z", "y", "x"} {
+		sig.AddListener(r.listener(k), k)
+	}
+	sig.RemoveListener("z")
+
+	want = []string{"y", "x"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v after reset, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_OrderPreservedWithUnkeyedListeners(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	sig.AddListener(r.listener("1"))
+	sig.AddListener(r.listener("2"), "two")
+	sig.AddListener(r.listener("3"))
+	sig.AddListener(r.listener("4"), "four")
+	sig.AddListener(r.listener("5"))
+
+	sig.RemoveListener("two")
+
+	want := []string{"1", "3", "4", "5"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_ZeroValueOrderPreserved(t *testing.T) {
+	var sig signals.SyncSignal[int]
+	r := &orderRecorder{}
+	for _, k := range []string{"a", "b", "c"} {
+		sig.AddListener(r.listener(k), k)
+	}
+
+	sig.RemoveListener("a")
+
+	want := []string{"b", "c"}
+	if got := r.emit(&sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_OrderUnstableUsesSwapRemove(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{ListenerOrder: signals.OrderUnstable})
+	r := &orderRecorder{}
+	for _, k := range []string{"a", "b", "c", "d"} {
+		sig.AddListener(r.listener(k), k)
+	}
+
+	sig.RemoveListener("a")
+
+	want := []string{"d", "b", "c"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected swap-remove order %v, got %v", want, got)
+	}
+}
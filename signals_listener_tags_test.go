@@ -0,0 +1,156 @@
+package signals_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+// addTagged registers a recording listener with the given key and tags.
+func addTagged(t *testing.T, sig *signals.SyncSignal[int], r *orderRecorder, key string, tags ...string) {
+	t.Helper()
+	if _, err := sig.AddListenerWithOptions(r.listener(key), signals.ListenerOptions{Key: key, Tags: tags}); err != nil {
+		t.Fatalf("Unexpected error adding %q: %v", key, err)
+	}
+}
+
+func TestSyncSignal_RemoveListenersByTag(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	addTagged(t, sig, r, "core")
+	addTagged(t, sig, r, "billing.charge", "billing")
+	addTagged(t, sig, r, "audit", "compliance")
+	addTagged(t, sig, r, "billing.invoice", "billing", "compliance")
+
+	if n := sig.RemoveListenersByTag("billing"); n != 2 {
+		t.Fatalf("Expected 2 listeners removed, got %d", n)
+	}
+	if got := r.emit(sig); !equalOrder(got, []string{"core", "audit"}) {
+		t.Fatalf("Expected [core audit], got %v", got)
+	}
+	if sig.Has("billing.charge") {
+		t.Fatal("Expected removed key to be released")
+	}
+	if n := sig.RemoveListenersByTag("billing"); n != 0 {
+		t.Fatalf("Expected nothing left to remove, got %d", n)
+	}
+}
+
+func TestSyncSignal_RemoveListenersByPrefix(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	sig.AddListener(r.listener("unkeyed"))
+	for _, k := range []string{"plugin.a", "core", "plugin.b", "plugins"} {
+		sig.AddListener(r.listener(k), k)
+	}
+	sub := sig.Subscribe(r.listener("plugin.c"), "plugin.c")
+
+	if n := sig.RemoveListenersByPrefix("plugin."); n != 3 {
+		t.Fatalf("Expected 3 listeners removed, got %d", n)
+	}
+	if sub.Active() {
+		t.Fatal("Expected subscription to be inactive")
+	}
+	if got := r.emit(sig); !equalOrder(got, []string{"unkeyed", "core", "plugins"}) {
+		t.Fatalf("Expected [unkeyed core plugins], got %v", got)
+	}
+}
+
+func TestSyncSignal_EmitWithOptionsTags(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	addTagged(t, sig, r, "plain")
+	addTagged(t, sig, r, "billing", "billing")
+	addTagged(t, sig, r, "audit", "compliance")
+	addTagged(t, sig, r, "invoice", "billing", "compliance")
+
+	tests := []struct {
+		name string
+		opts signals.EmitOptions
+		want []string
+	}{
+		{"zero value", signals.EmitOptions{}, []string{"plain", "billing", "audit", "invoice"}},
+		{"include", signals.EmitOptions{IncludeTags: []string{"billing"}}, []string{"billing", "invoice"}},
+		{"exclude", signals.EmitOptions{ExcludeTags: []string{"billing"}}, []string{"plain", "audit"}},
+		{"exclude wins", signals.EmitOptions{
+			IncludeTags: []string{"compliance"},
+			ExcludeTags: []string{"billing"},
+		}, []string{"audit"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.order = r.order[:0]
+			sig.EmitWithOptions(context.Background(), 1, tt.opts)
+			if !equalOrder(r.order, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, r.order)
+			}
+
+			r.order = r.order[:0]
+			if err := sig.TryEmitWithOptions(context.Background(), 1, tt.opts); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalOrder(r.order, tt.want) {
+				t.Fatalf("Expected %v from TryEmitWithOptions, got %v", tt.want, r.order)
+			}
+		})
+	}
+}
+
+func TestSyncSignal_EmitWithOptionsSkipsOnceListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	calls := 0
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) { calls++ }, signals.ListenerOptions{
+		Once: true,
+		Tags: []string{"late"},
+	})
+
+	sig.EmitWithOptions(context.Background(), 1, signals.EmitOptions{ExcludeTags: []string{"late"}})
+	if calls != 0 || sig.Len() != 1 {
+		t.Fatalf("Expected excluded once listener to stay registered, calls=%d len=%d", calls, sig.Len())
+	}
+	sig.Emit(context.Background(), 2)
+	if calls != 1 || sig.Len() != 0 {
+		t.Fatalf("Expected once listener to fire on a full emit, calls=%d len=%d", calls, sig.Len())
+	}
+}
+
+func TestAsyncSignal_EmitWithOptionsTags(t *testing.T) {
+	sig := signals.New[int]()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var got []string
+	for _, k := range []string{"billing", "audit"} {
+		key := k
+		sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+			mu.Lock()
+			got = append(got, key)
+			mu.Unlock()
+			wg.Done()
+		}, signals.ListenerOptions{Key: key, Tags: []string{key}})
+	}
+
+	wg.Add(1)
+	if err := sig.TryEmitWithOptions(context.Background(), 1, signals.EmitOptions{IncludeTags: []string{"audit"}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wg.Wait()
+	wg.Add(1)
+	sig.EmitWithOptions(context.Background(), 2, signals.EmitOptions{ExcludeTags: []string{"audit"}})
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !equalOrder(got, []string{"audit", "billing"}) {
+		t.Fatalf("Expected [audit billing], got %v", got)
+	}
+	if n := sig.RemoveListenersByTag("audit"); n != 1 || sig.Len() != 1 {
+		t.Fatalf("Expected audit listener removed, got n=%d len=%d", n, sig.Len())
+	}
+	if n := sig.RemoveListenersByPrefix("bill"); n != 1 || !sig.IsEmpty() {
+		t.Fatalf("Expected billing listener removed, got n=%d len=%d", n, sig.Len())
+	}
+}
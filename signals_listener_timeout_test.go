@@ -0,0 +1,214 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_ListenerTimeoutDerivesDeadline(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var hasDeadline bool
+	sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error {
+		_, hasDeadline = ctx.Deadline()
+		<-ctx.Done()
+		return ctx.Err()
+	}, signals.ListenerOptions{Key: "slow", Timeout: 5 * time.Millisecond})
+
+	err := sig.TryEmit(context.Background(), 1)
+	if !hasDeadline {
+		t.Fatal("Expected the listener context to carry a deadline")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the listener's deadline error, got %v", err)
+	}
+	var terr *signals.ListenerTimeoutError
+	if errors.As(err, &terr) {
+		t.Fatal("Expected no ListenerTimeoutError without StrictTimeout")
+	}
+}
+
+func TestSyncSignal_StrictTimeoutAbandonsListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+	release := make(chan struct{})
+	defer close(release)
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		<-release // ignores its context
+	}, signals.ListenerOptions{Key: "stuck", Timeout: 10 * time.Millisecond, StrictTimeout: true})
+
+	start := time.Now()
+	err := sig.TryEmit(context.Background(), 1)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Expected TryEmit to return at the deadline, took %v", elapsed)
+	}
+
+	var terr *signals.ListenerTimeoutError
+	if !errors.As(err, &terr) {
+		t.Fatalf("Expected *ListenerTimeoutError, got %v", err)
+	}
+	if terr.Key != "stuck" || terr.Timeout != 10*time.Millisecond {
+		t.Fatalf("Unexpected timeout error %+v", terr)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected ListenerTimeoutError to match context.DeadlineExceeded")
+	}
+}
+
+func TestSyncSignal_StrictTimeoutEmitContinues(t *testing.T) {
+	sig := signals.NewSync[int]()
+	release := make(chan struct{})
+	defer close(release)
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		<-release
+	}, signals.ListenerOptions{Key: "stuck", Timeout: 5 * time.Millisecond, StrictTimeout: true})
+	var ran bool
+	sig.AddListener(func(ctx context.Context, v int) { ran = true })
+
+	sig.Emit(context.Background(), 1)
+	if !ran {
+		t.Fatal("Expected Emit to move on after the strict timeout")
+	}
+}
+
+func TestSyncSignal_StrictTimeoutFastListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+	want := errors.New("rejected")
+	sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error {
+		return want
+	}, signals.ListenerOptions{Timeout: time.Second, StrictTimeout: true})
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, want) {
+		t.Fatalf("Expected the listener error, got %v", err)
+	}
+}
+
+func TestSyncSignal_StrictTimeoutParentCancelled(t *testing.T) {
+	sig := signals.NewSync[int]()
+	release := make(chan struct{})
+	defer close(release)
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		<-release
+	}, signals.ListenerOptions{Timeout: time.Hour, StrictTimeout: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	err := sig.TryEmit(ctx, 1)
+	var terr *signals.ListenerTimeoutError
+	if errors.As(err, &terr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the emit context error, got %v", err)
+	}
+}
+
+func TestSyncSignal_StrictTimeoutTryEmitAll(t *testing.T) {
+	sig := signals.NewSync[int]()
+	release := make(chan struct{})
+	defer close(release)
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		<-release
+	}, signals.ListenerOptions{Key: "stuck", Timeout: 5 * time.Millisecond, StrictTimeout: true})
+	var ran bool
+	sig.AddListener(func(ctx context.Context, v int) { ran = true })
+
+	err := sig.TryEmitAll(context.Background(), 1)
+	var lerr *signals.ListenerError
+	if !errors.As(err, &lerr) || lerr.Key != "stuck" {
+		t.Fatalf("Expected a ListenerError for the stuck listener, got %v", err)
+	}
+	var terr *signals.ListenerTimeoutError
+	if !errors.As(err, &terr) {
+		t.Fatalf("Expected the ListenerError to wrap *ListenerTimeoutError, got %v", err)
+	}
+	if !ran {
+		t.Fatal("Expected the remaining listeners to run")
+	}
+}
+
+func TestSyncSignal_StrictTimeoutPanic(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		panic("boom")
+	}, signals.ListenerOptions{Key: "bad", Timeout: time.Second, StrictTimeout: true})
+
+	err := sig.TryEmit(context.Background(), 1)
+	var perr *signals.PanicError
+	if !errors.As(err, &perr) || perr.Key != "bad" || perr.Value != "boom" {
+		t.Fatalf("Expected the panic to surface as *PanicError, got %v", err)
+	}
+}
+
+// strictPanickingListener writes to a nil map.
+func strictPanickingListener(ctx context.Context, v int) {
+	var m map[string]int
+	m["x"] = v
+}
+
+func TestSyncSignal_StrictTimeoutPanicStack(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+	sig.AddListenerWithOptions(strictPanickingListener, signals.ListenerOptions{
+		Key: "bad", Timeout: time.Second, StrictTimeout: true,
+	})
+
+	err := sig.TryEmit(context.Background(), 1)
+	var perr *signals.PanicError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if _, nested := perr.Value.(*signals.PanicError); nested {
+		t.Fatal("Expected the PanicError not to be wrapped again")
+	}
+	if !strings.Contains(string(perr.Stack), "strictPanickingListener") {
+		t.Fatalf("Expected the stack to include the listener, got:\n%s", perr.Stack)
+	}
+}
+
+func TestSyncSignal_StrictTimeoutAbandonedPanic(t *testing.T) {
+	sig := signals.NewSync[int]()
+	panics := make(chan *signals.PanicError, 1)
+	sig.SetPanicHandler(func(p *signals.PanicError) { panics <- p })
+	release := make(chan struct{})
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		<-release
+		panic("late")
+	}, signals.ListenerOptions{Key: "late", Timeout: 5 * time.Millisecond, StrictTimeout: true})
+
+	if err := sig.TryEmit(context.Background(), 1); err == nil {
+		t.Fatal("Expected a timeout error")
+	}
+	close(release)
+
+	select {
+	case p := <-panics:
+		if p.Key != "late" || p.Value != "late" {
+			t.Fatalf("Unexpected panic report %+v", p)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the abandoned listener's panic to reach the panic handler")
+	}
+}
+
+func TestAsyncSignal_StrictTimeoutReportsError(t *testing.T) {
+	sig := signals.New[int]()
+	errs := make(chan error, 1)
+	sig.SetErrorHandler(func(err error, payload int, key string) { errs <- err })
+	release := make(chan struct{})
+	defer close(release)
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		<-release
+	}, signals.ListenerOptions{Key: "stuck", Timeout: 5 * time.Millisecond, StrictTimeout: true})
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case err := <-errs:
+		var terr *signals.ListenerTimeoutError
+		if !errors.As(err, &terr) || terr.Key != "stuck" {
+			t.Fatalf("Expected *ListenerTimeoutError, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the timeout to be reported to the error handler")
+	}
+}
@@ -0,0 +1,215 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_OnceListenerInvokedOnce(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	calls := 0
+	other := 0
+	if n := sig.AddOnceListener(func(ctx context.Context, v int) { calls++ }); n != 1 {
+		t.Fatalf("Expected 1 subscriber, got %d", n)
+	}
+	sig.AddListener(func(ctx context.Context, v int) { other++ })
+
+	sig.Emit(context.Background(), 1)
+	if sig.Len() != 1 {
+		t.Fatalf("Expected once listener to be removed on delivery, got %d listeners", sig.Len())
+	}
+	sig.Emit(context.Background(), 2)
+
+	if calls != 1 {
+		t.Fatalf("Expected once listener to be called once, got %d", calls)
+	}
+	if other != 2 {
+		t.Fatalf("Expected regular listener to be called twice, got %d", other)
+	}
+}
+
+func TestSyncSignal_OnceListenerKeyReleased(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	sig.AddOnceListener(func(ctx context.Context, v int) {}, "ready")
+	if n := sig.AddOnceListener(func(ctx context.Context, v int) {}, "ready"); n != -1 {
+		t.Fatalf("Expected -1 for duplicate key, got %d", n)
+	}
+
+	sig.Emit(context.Background(), 1)
+
+	if n := sig.AddOnceListener(func(ctx context.Context, v int) {}, "ready"); n != 1 {
+		t.Fatalf("Expected key to be free after delivery, got %d", n)
+	}
+	if n := sig.RemoveListener("ready"); n != 0 {
+		t.Fatalf("Expected once listener to be removable before delivery, got %d", n)
+	}
+}
+
+func TestSyncSignal_OnceListenerWithErr(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	errBoom := errors.New("boom")
+	sig.AddOnceListenerWithErr(func(ctx context.Context, v int) error { return errBoom }, "k")
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errBoom) {
+		t.Fatalf("Expected boom on first emit, got %v", err)
+	}
+	if err := sig.TryEmit(context.Background(), 2); err != nil {
+		t.Fatalf("Expected once listener not to run again, got %v", err)
+	}
+	if !sig.IsEmpty() {
+		t.Fatal("Expected once listener to be removed")
+	}
+}
+
+func TestSyncSignal_OnceListenerKeptWhenNotReached(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	calls := 0
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		if v == 1 {
+			return errors.New("stop")
+		}
+		return nil
+	})
+	sig.AddOnceListener(func(ctx context.Context, v int) { calls++ })
+
+	_ = sig.TryEmit(context.Background(), 1)
+	if calls != 0 || sig.Len() != 2 {
+		t.Fatalf("Expected once listener to stay registered, calls=%d len=%d", calls, sig.Len())
+	}
+
+	_ = sig.TryEmit(context.Background(), 2)
+	if calls != 1 || sig.Len() != 1 {
+		t.Fatalf("Expected once listener to be delivered, calls=%d len=%d", calls, sig.Len())
+	}
+}
+
+func TestSyncSignal_OnceListenerTryEmitAllAndIsolated(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+
+	calls := 0
+	sig.AddOnceListener(func(ctx context.Context, v int) { calls++ })
+
+	sig.Emit(context.Background(), 1)
+	_ = sig.TryEmit(context.Background(), 2)
+	_ = sig.TryEmitAll(context.Background(), 3)
+
+	if calls != 1 {
+		t.Fatalf("Expected once listener to be called once, got %d", calls)
+	}
+}
+
+func TestSyncSignal_OnceListenerConcurrentEmits(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var calls int32
+	sig.AddOnceListener(func(ctx context.Context, v int) { atomic.AddInt32(&calls, 1) })
+	sig.AddListener(func(ctx context.Context, v int) {})
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			switch i % 3 {
+			case 0:
+				sig.Emit(context.Background(), i)
+			case 1:
+				_ = sig.TryEmit(context.Background(), i)
+			default:
+				_ = sig.TryEmitAll(context.Background(), i)
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("Expected exactly one delivery under concurrent emits, got %d", n)
+	}
+	if sig.Len() != 1 {
+		t.Fatalf("Expected once listener to be removed, got %d listeners", sig.Len())
+	}
+}
+
+func TestSyncSignal_OnceListenerWithOptions(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	sig.AddListener(r.listener("b"), "b")
+	if _, err := sig.AddListenerWithOptions(r.listener("a"), signals.ListenerOptions{
+		Key:    "a",
+		Before: []string{"b"},
+		Once:   true,
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []string{"a", "b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+	want = []string{"b"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v after once delivery, got %v", want, got)
+	}
+}
+
+func TestAsyncSignal_OnceListenerConcurrentEmits(t *testing.T) {
+	for _, opts := range []*signals.SignalOptions{nil, {WorkerPoolSize: 4}} {
+		sig := signals.NewWithOptions[int](opts)
+
+		var calls int32
+		delivered := make(chan struct{}, 100)
+		sig.AddOnceListener(func(ctx context.Context, v int) {
+			atomic.AddInt32(&calls, 1)
+			delivered <- struct{}{}
+		})
+		sig.AddOnceListenerWithErr(func(ctx context.Context, v int) error {
+			atomic.AddInt32(&calls, 1)
+			delivered <- struct{}{}
+			return nil
+		}, "err")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if i%2 == 0 {
+					sig.Emit(context.Background(), i)
+					return
+				}
+				_ = sig.EmitAndWait(context.Background(), i)
+			}(i)
+		}
+		wg.Wait()
+
+		for i := 0; i < 2; i++ {
+			select {
+			case <-delivered:
+			case <-time.After(time.Second):
+				t.Fatal("Expected both once listeners to be delivered")
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		if n := atomic.LoadInt32(&calls); n != 2 {
+			t.Fatalf("Expected each once listener to be called once, got %d calls", n)
+		}
+		if !sig.IsEmpty() {
+			t.Fatalf("Expected once listeners to be removed, got %d listeners", sig.Len())
+		}
+	}
+}
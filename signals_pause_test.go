@@ -0,0 +1,291 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_PauseDropsEmits(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var got []int
+	sig.AddListener(func(ctx context.Context, v int) { got = append(got, v) })
+
+	sig.Pause()
+	if !sig.IsPaused() {
+		t.Fatal("Expected signal to be paused")
+	}
+	sig.Emit(context.Background(), 1)
+	if err := sig.TryEmit(context.Background(), 2); !errors.Is(err, signals.ErrPaused) {
+		t.Fatalf("Expected ErrPaused from TryEmit, got %v", err)
+	}
+	if err := sig.TryEmitAll(context.Background(), 3); !errors.Is(err, signals.ErrPaused) {
+		t.Fatalf("Expected ErrPaused from TryEmitAll, got %v", err)
+	}
+
+	sig.Resume()
+	if sig.IsPaused() {
+		t.Fatal("Expected signal to be resumed")
+	}
+	sig.Emit(context.Background(), 4)
+
+	if !equalInts(got, []int{4}) {
+		t.Fatalf("Expected only the emit after Resume, got %v", got)
+	}
+	if sig.Len() != 1 {
+		t.Fatalf("Expected listener to stay registered, got %d", sig.Len())
+	}
+}
+
+func TestSyncSignal_PauseBufferFlushesInOrder(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{
+		PausePolicy:     signals.PauseBuffer,
+		PauseBufferSize: 3,
+	})
+
+	var got []int
+	sig.AddListener(func(ctx context.Context, v int) { got = append(got, v) })
+
+	sig.Pause()
+	for i := 1; i <= 5; i++ {
+		sig.Emit(context.Background(), i)
+	}
+	if len(got) != 0 {
+		t.Fatalf("Expected no delivery while paused, got %v", got)
+	}
+	// TryEmit is never buffered
+	if err := sig.TryEmit(context.Background(), 6); !errors.Is(err, signals.ErrPaused) {
+		t.Fatalf("Expected ErrPaused from TryEmit, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sig.Resume()
+	sig.Pause()
+	sig.Emit(ctx, 7)
+	cancel()
+	sig.Resume()
+
+	if !equalInts(got, []int{1, 2, 3}) {
+		t.Fatalf("Expected buffered emits [1 2 3], got %v", got)
+	}
+}
+
+func TestSyncSignal_EmitsDuringFlushStayOrdered(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{PausePolicy: signals.PauseBuffer})
+
+	var got []int
+	sig.AddListener(func(ctx context.Context, v int) {
+		got = append(got, v)
+		if v == 1 {
+			// Emitted while the buffer is flushed: must run after 2
+			sig.Emit(ctx, 3)
+		}
+	})
+
+	sig.Pause()
+	sig.Emit(context.Background(), 1)
+	sig.Emit(context.Background(), 2)
+	sig.Resume()
+
+	if !equalInts(got, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], got %v", got)
+	}
+}
+
+func TestSyncSignal_PauseDuringFlushFromListener(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{PausePolicy: signals.PauseBuffer})
+
+	var got []int
+	sig.AddListener(func(ctx context.Context, v int) {
+		got = append(got, v)
+		if v == 1 && len(got) == 2 {
+			sig.Pause()
+		}
+	})
+
+	sig.Pause()
+	for i := 0; i < 5; i++ {
+		sig.Emit(context.Background(), i)
+	}
+	sig.Resume()
+
+	if !equalInts(got, []int{0, 1}) {
+		t.Fatalf("Expected flushing to stop after the pause, got %v", got)
+	}
+	if !sig.IsPaused() {
+		t.Fatal("Expected signal to be paused again")
+	}
+
+	sig.Resume()
+	if !equalInts(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("Expected the remaining emits on the next Resume, got %v", got)
+	}
+}
+
+func TestSyncSignal_PauseDuringFlushFromGoroutine(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{PausePolicy: signals.PauseBuffer})
+
+	var got []int
+	paused := make(chan struct{})
+	sig.AddListener(func(ctx context.Context, v int) {
+		got = append(got, v)
+		if v == 1 && len(got) == 2 {
+			go func() {
+				sig.Pause()
+				close(paused)
+			}()
+			<-paused
+		}
+	})
+
+	sig.Pause()
+	for i := 0; i < 5; i++ {
+		sig.Emit(context.Background(), i)
+	}
+	sig.Resume()
+
+	if !equalInts(got, []int{0, 1}) {
+		t.Fatalf("Expected flushing to stop after the pause, got %v", got)
+	}
+	sig.Resume()
+	if !equalInts(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("Expected the remaining emits on the next Resume, got %v", got)
+	}
+}
+
+func TestSyncSignal_TryEmitDuringFlush(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{PausePolicy: signals.PauseBuffer})
+
+	var tryErr error
+	var paused bool
+	sig.AddListener(func(ctx context.Context, v int) {
+		if v == 1 {
+			paused = sig.IsPaused()
+			tryErr = sig.TryEmit(ctx, 3)
+		}
+	})
+
+	sig.Pause()
+	sig.Emit(context.Background(), 1)
+	sig.Emit(context.Background(), 2)
+	sig.Resume()
+
+	if paused {
+		t.Fatal("Expected IsPaused to be false during the flush")
+	}
+	if !errors.Is(tryErr, signals.ErrPaused) {
+		t.Fatalf("Expected ErrPaused from TryEmit during the flush, got %v", tryErr)
+	}
+}
+
+func TestSyncSignal_MuteListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	for _, k := range []string{"a", "b", "c"} {
+		sig.AddListener(r.listener(k), k)
+	}
+
+	if !sig.Mute("b") || sig.Mute("missing") {
+		t.Fatal("Unexpected Mute result")
+	}
+	if got := r.emit(sig); !equalOrder(got, []string{"a", "c"}) {
+		t.Fatalf("Expected [a c] while b is muted, got %v", got)
+	}
+	r.order = r.order[:0]
+	if err := sig.TryEmitAll(context.Background(), 1); err != nil || !equalOrder(r.order, []string{"a", "c"}) {
+		t.Fatalf("Expected TryEmitAll to skip b, got %v (err %v)", r.order, err)
+	}
+
+	if !sig.Unmute("b") || sig.Unmute("missing") {
+		t.Fatal("Unexpected Unmute result")
+	}
+	if got := r.emit(sig); !equalOrder(got, []string{"a", "b", "c"}) {
+		t.Fatalf("Expected [a b c] after Unmute, got %v", got)
+	}
+}
+
+func TestSyncSignal_MutedOnceListenerNotConsumed(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	calls := 0
+	sig.AddOnceListener(func(ctx context.Context, v int) { calls++ }, "once")
+	sig.Mute("once")
+	sig.Emit(context.Background(), 1)
+	sig.Unmute("once")
+	sig.Emit(context.Background(), 2)
+
+	if calls != 1 || sig.Len() != 0 {
+		t.Fatalf("Expected once listener to fire after Unmute, calls=%d len=%d", calls, sig.Len())
+	}
+}
+
+func TestAsyncSignal_PauseBufferAndMute(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{PausePolicy: signals.PauseBuffer})
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var got []int
+	sig.AddListener(func(ctx context.Context, v int) {
+		mu.Lock()
+		got = append(got, v)
+		mu.Unlock()
+		wg.Done()
+	}, "record")
+	sig.AddListener(func(ctx context.Context, v int) {
+		t.Error("muted listener must not run")
+	}, "muted")
+	sig.Mute("muted")
+
+	sig.Pause()
+	sig.Emit(context.Background(), 1)
+	if err := sig.TryEmit(context.Background(), 2); err != nil {
+		t.Fatalf("Expected buffered TryEmit to succeed, got %v", err)
+	}
+	if err := sig.EmitAndWait(context.Background(), 3); !errors.Is(err, signals.ErrPaused) {
+		t.Fatalf("Expected ErrPaused from EmitAndWait, got %v", err)
+	}
+
+	wg.Add(2)
+	sig.Resume()
+	wg.Wait()
+
+	wg.Add(1)
+	if err := sig.EmitAndWait(context.Background(), 4); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != 3 || got[2] != 4 {
+		t.Fatalf("Expected buffered emits then 4, got %v", got)
+	}
+}
+
+func TestAsyncSignal_PauseDropReportsTryEmit(t *testing.T) {
+	sig := signals.New[int]()
+	sig.AddListener(func(ctx context.Context, v int) {
+		t.Error("listener must not run while paused")
+	})
+
+	sig.Pause()
+	sig.Emit(context.Background(), 1)
+	if err := sig.TryEmit(context.Background(), 2); !errors.Is(err, signals.ErrPaused) {
+		t.Fatalf("Expected ErrPaused, got %v", err)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
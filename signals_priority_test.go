@@ -0,0 +1,130 @@
+package signals_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_PriorityOrdersListeners(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	sig.AddListener(r.listener("persist"), "persist")
+	sig.AddListenerWithPriority(r.listener("audit"), 10, "audit")
+	sig.AddListenerWithPriority(r.listener("cleanup"), -5, "cleanup")
+	sig.AddListenerWithPriority(r.listener("auth"), 100, "auth")
+	sig.AddListener(r.listener("notify"), "notify")
+
+	want := []string{"auth", "audit", "persist", "notify", "cleanup"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_PriorityStableWithinEqualPriorities(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+
+	sig.AddListenerWithPriority(r.listener("a1"), 1)
+	sig.AddListenerWithPriority(r.listener("b1"), 2)
+	sig.AddListenerWithPriority(r.listener("a2"), 1)
+	sig.AddListenerWithPriority(r.listener("b2"), 2)
+	sig.AddListenerWithPriority(r.listener("a3"), 1)
+
+	want := []string{"b1", "b2", "a1", "a2", "a3"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_ErrorListenerPriority(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var order []string
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error {
+		order = append(order, "persist")
+		return nil
+	}, "persist")
+	count := sig.AddListenerWithErrPriority(func(ctx context.Context, v int) error {
+		order = append(order, "validate")
+		return nil
+	}, 50, "validate")
+	if count != 2 {
+		t.Fatalf("Expected 2 subscribers, got %d", count)
+	}
+
+	if err := sig.TryEmit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	if len(order) != 2 || order[0] != "validate" || order[1] != "persist" {
+		t.Fatalf("Expected [validate persist], got %v", order)
+	}
+}
+
+func TestSyncSignal_PriorityDuplicateKey(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	sig.AddListener(func(ctx context.Context, v int) {}, "k")
+	if n := sig.AddListenerWithPriority(func(ctx context.Context, v int) {}, 5, "k"); n != -1 {
+		t.Fatalf("Expected -1 for duplicate key, got %d", n)
+	}
+	if n := sig.AddListenerWithErrPriority(func(ctx context.Context, v int) error { return nil }, 5, "k"); n != -1 {
+		t.Fatalf("Expected -1 for duplicate key, got %d", n)
+	}
+}
+
+func TestSyncSignal_PriorityOrderSurvivesUnstableRemoval(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{ListenerOrder: signals.OrderUnstable})
+	r := &orderRecorder{}
+
+	sig.AddListenerWithPriority(r.listener("high"), 10, "high")
+	sig.AddListener(r.listener("mid1"), "mid1")
+	sig.AddListener(r.listener("mid2"), "mid2")
+	sig.AddListenerWithPriority(r.listener("low"), -10, "low")
+
+	sig.RemoveListener("high")
+
+	want := []string{"mid1", "mid2", "low"}
+	if got := r.emit(sig); !equalOrder(got, want) {
+		t.Fatalf("Expected order %v, got %v", want, got)
+	}
+}
+
+func TestSyncSignal_PriorityNilListenerPanics(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected panic for nil listener")
+		}
+	}()
+	sig.AddListenerWithPriority(nil, 1)
+}
+
+func TestAsyncSignal_PriorityOrdersDispatch(t *testing.T) {
+	sig := signals.NewWithOptions[int](&signals.SignalOptions{WorkerPoolSize: 1})
+
+	got := make(chan string, 3)
+	sig.AddListener(func(ctx context.Context, v int) { got <- "low" }, "low")
+	sig.AddListenerWithErrPriority(func(ctx context.Context, v int) error {
+		got <- "mid"
+		return nil
+	}, 5, "mid")
+	sig.AddListenerWithPriority(func(ctx context.Context, v int) { got <- "high" }, 10, "high")
+
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	close(got)
+
+	var order []string
+	for k := range got {
+		order = append(order, k)
+	}
+	want := []string{"high", "mid", "low"}
+	if !equalOrder(order, want) {
+		t.Fatalf("Expected dispatch order %v, got %v", want, order)
+	}
+}
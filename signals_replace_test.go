@@ -0,0 +1,99 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_ReplaceListenerKeepsPosition(t *testing.T) {
+	sig := signals.NewSync[int]()
+	r := &orderRecorder{}
+	for _, k := range []string{"a", "b", "c"} {
+		sig.AddListener(r.listener(k), k)
+	}
+
+	if !sig.ReplaceListener("b", r.listener("b2")) {
+		t.Fatal("Expected ReplaceListener to find key b")
+	}
+	if got := r.emit(sig); !equalOrder(got, []string{"a", "b2", "c"}) {
+		t.Fatalf("Expected [a b2 c], got %v", got)
+	}
+	if sig.ReplaceListener("missing", r.listener("x")) {
+		t.Fatal("Expected ReplaceListener to report a missing key")
+	}
+	if sig.Len() != 3 {
+		t.Fatalf("Expected 3 listeners, got %d", sig.Len())
+	}
+}
+
+func TestSyncSignal_ReplaceListenerWithErr(t *testing.T) {
+	sig := signals.NewSync[int]()
+	sig.AddListenerWithPriority(func(ctx context.Context, v int) {}, 10, "k")
+	sig.AddListener(func(ctx context.Context, v int) {}, "other")
+
+	errBoom := errors.New("boom")
+	if !sig.ReplaceListenerWithErr("k", func(ctx context.Context, v int) error { return errBoom }) {
+		t.Fatal("Expected ReplaceListenerWithErr to find key k")
+	}
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errBoom) {
+		t.Fatalf("Expected errBoom from replaced listener, got %v", err)
+	}
+	if infos := sig.Listeners(); infos[0].Key != "k" || infos[0].Priority != 10 {
+		t.Fatalf("Expected replaced listener to keep its priority, got %+v", infos[0])
+	}
+
+	calls := 0
+	sig.ReplaceListener("k", func(ctx context.Context, v int) { calls++ })
+	if err := sig.TryEmit(context.Background(), 1); err != nil || calls != 1 {
+		t.Fatalf("Expected plain listener after second replace, err=%v calls=%d", err, calls)
+	}
+}
+
+func TestSyncSignal_ReplaceListenerNeverMissesEmit(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	var calls atomic.Int64
+	listener := func(ctx context.Context, v int) { calls.Add(1) }
+	sig.AddListener(listener, "k")
+
+	const emits = 1000
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < emits; i++ {
+			sig.ReplaceListener("k", listener)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < emits; i++ {
+			sig.Emit(context.Background(), i)
+		}
+	}()
+	wg.Wait()
+
+	if n := calls.Load(); n != emits {
+		t.Fatalf("Expected every emit to reach a listener, got %d of %d", n, emits)
+	}
+}
+
+func TestAsyncSignal_ReplaceListener(t *testing.T) {
+	sig := signals.New[int]()
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return errors.New("old") }, "k")
+
+	if !sig.ReplaceListenerWithErr("k", func(ctx context.Context, v int) error { return nil }) {
+		t.Fatal("Expected ReplaceListenerWithErr to find key k")
+	}
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected replaced listener to succeed, got %v", err)
+	}
+	if !sig.ReplaceListener("k", func(ctx context.Context, v int) {}) || sig.ReplaceListener("x", func(ctx context.Context, v int) {}) {
+		t.Fatal("Unexpected ReplaceListener result")
+	}
+}
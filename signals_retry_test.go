@@ -0,0 +1,190 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maniartech/signals"
+)
+
+var errTransient = errors.New("transient")
+
+// flaky returns a listener that fails with errTransient until it has been called
+// failures times, counting its calls in calls.
+func flaky(failures int32, calls *atomic.Int32) signals.SignalListenerErr[int] {
+	return func(ctx context.Context, v int) error {
+		if calls.Add(1) <= failures {
+			return errTransient
+		}
+		return nil
+	}
+}
+
+func TestSyncSignal_RetrySucceeds(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(2, &calls), signals.ListenerOptions{
+		Retry: &signals.RetryPolicy{MaxAttempts: 3},
+	})
+
+	if err := sig.TryEmit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected the third attempt to succeed, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestSyncSignal_RetryExhausted(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(10, &calls), signals.ListenerOptions{
+		Key:   "save",
+		Retry: &signals.RetryPolicy{MaxAttempts: 4},
+	})
+
+	err := sig.TryEmit(context.Background(), 1)
+	var rerr *signals.RetryError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("Expected *RetryError, got %v", err)
+	}
+	if rerr.Key != "save" || rerr.Attempts != 4 || !errors.Is(err, errTransient) {
+		t.Fatalf("Unexpected retry error %+v", rerr)
+	}
+	if calls.Load() != 4 {
+		t.Fatalf("Expected 4 attempts, got %d", calls.Load())
+	}
+}
+
+func TestSyncSignal_RetryNotRetryable(t *testing.T) {
+	sig := signals.NewSync[int]()
+	permanent := errors.New("permanent")
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(func(ctx context.Context, v int) error {
+		calls.Add(1)
+		return permanent
+	}, signals.ListenerOptions{
+		Retry: &signals.RetryPolicy{
+			MaxAttempts: 5,
+			Retryable:   func(err error) bool { return errors.Is(err, errTransient) },
+		},
+	})
+
+	err := sig.TryEmit(context.Background(), 1)
+	var rerr *signals.RetryError
+	if !errors.As(err, &rerr) || rerr.Attempts != 1 || !errors.Is(err, permanent) {
+		t.Fatalf("Expected a single attempt, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestSyncSignal_RetryBackoff(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(2, &calls), signals.ListenerOptions{
+		Retry: &signals.RetryPolicy{MaxAttempts: 3, Backoff: 5 * time.Millisecond, Multiplier: 2},
+	})
+
+	start := time.Now()
+	if err := sig.TryEmit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	// 5ms before the second attempt, 10ms before the third
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Fatalf("Expected backoff of at least 15ms, took %v", elapsed)
+	}
+}
+
+func TestSyncSignal_RetryStopsOnCancel(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(10, &calls), signals.ListenerOptions{
+		Retry: &signals.RetryPolicy{MaxAttempts: 5, Backoff: time.Hour, Jitter: 0.5},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := sig.TryEmit(ctx, 1)
+	var rerr *signals.RetryError
+	if !errors.As(err, &rerr) || rerr.Attempts != 1 {
+		t.Fatalf("Expected retrying to stop after the first attempt, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || !errors.Is(err, errTransient) {
+		t.Fatalf("Expected the error to wrap both the context and listener errors, got %v", err)
+	}
+}
+
+func TestSyncSignal_RetryCancelledDuringBackoff(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(10, &calls), signals.ListenerOptions{
+		Retry: &signals.RetryPolicy{MaxAttempts: 5, Backoff: time.Hour},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(5*time.Millisecond, cancel)
+	err := sig.TryEmit(ctx, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the cancellation to be reported, got %v", err)
+	}
+	var rerr *signals.RetryError
+	if !errors.As(err, &rerr) || rerr.ContextErr != context.Canceled {
+		t.Fatalf("Expected RetryError.ContextErr to be set, got %v", err)
+	}
+}
+
+func TestSyncSignal_RetryIgnoredForPlainListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithOptions(func(ctx context.Context, v int) {
+		calls.Add(1)
+	}, signals.ListenerOptions{Retry: &signals.RetryPolicy{MaxAttempts: 3}})
+
+	sig.Emit(context.Background(), 1)
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestAsyncSignal_RetryEmitAndWait(t *testing.T) {
+	sig := signals.New[int]()
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(1, &calls), signals.ListenerOptions{
+		Retry: &signals.RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond},
+	})
+
+	if err := sig.EmitAndWait(context.Background(), 1); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", calls.Load())
+	}
+}
+
+func TestAsyncSignal_RetryReportsFinalError(t *testing.T) {
+	sig := signals.New[int]()
+	errs := make(chan error, 1)
+	sig.SetErrorHandler(func(err error, payload int, key string) { errs <- err })
+	var calls atomic.Int32
+	sig.AddListenerWithErrOptions(flaky(10, &calls), signals.ListenerOptions{
+		Key:   "notify",
+		Retry: &signals.RetryPolicy{MaxAttempts: 3},
+	})
+
+	sig.Emit(context.Background(), 1)
+
+	select {
+	case err := <-errs:
+		var rerr *signals.RetryError
+		if !errors.As(err, &rerr) || rerr.Attempts != 3 || rerr.Key != "notify" {
+			t.Fatalf("Expected *RetryError after 3 attempts, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the final error to reach the error handler")
+	}
+}
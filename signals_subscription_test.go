@@ -0,0 +1,171 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_SubscribeUnkeyed(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	calls := 0
+	other := 0
+	sub := sig.Subscribe(func(ctx context.Context, v int) { calls++ })
+	sig.AddListener(func(ctx context.Context, v int) { other++ })
+
+	if !sub.Active() {
+		t.Fatal("Expected subscription to be active")
+	}
+	sig.Emit(context.Background(), 1)
+
+	if !sub.Unsubscribe() {
+		t.Fatal("Expected first Unsubscribe to remove the listener")
+	}
+	if sub.Unsubscribe() {
+		t.Fatal("Expected second Unsubscribe to be a no-op")
+	}
+	if sub.Active() {
+		t.Fatal("Expected subscription to be inactive after Unsubscribe")
+	}
+	if sig.Len() != 1 {
+		t.Fatalf("Expected 1 remaining listener, got %d", sig.Len())
+	}
+
+	sig.Emit(context.Background(), 2)
+	if calls != 1 || other != 2 {
+		t.Fatalf("Expected calls=1 other=2, got calls=%d other=%d", calls, other)
+	}
+}
+
+func TestSyncSignal_UnsubscribeFromInsideListener(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	calls := 0
+	var sub *signals.Subscription
+	sub = sig.Subscribe(func(ctx context.Context, v int) {
+		calls++
+		sub.Unsubscribe()
+	})
+
+	sig.Emit(context.Background(), 1)
+	sig.Emit(context.Background(), 2)
+
+	if calls != 1 {
+		t.Fatalf("Expected listener to run once, got %d", calls)
+	}
+	if sig.Len() != 0 {
+		t.Fatalf("Expected no listeners, got %d", sig.Len())
+	}
+}
+
+func TestSyncSignal_SubscribeWithErr(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	errBoom := errors.New("boom")
+	sub := sig.SubscribeWithErr(func(ctx context.Context, v int) error { return errBoom }, "k")
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errBoom) {
+		t.Fatalf("Expected errBoom, got %v", err)
+	}
+	sub.Unsubscribe()
+	if err := sig.TryEmit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected no error after Unsubscribe, got %v", err)
+	}
+}
+
+func TestSubscription_InactiveAfterOtherRemovals(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	byKey := sig.Subscribe(func(ctx context.Context, v int) {}, "k")
+	sig.RemoveListener("k")
+	if byKey.Active() {
+		t.Fatal("Expected subscription to be inactive after RemoveListener")
+	}
+	if byKey.Unsubscribe() {
+		t.Fatal("Expected Unsubscribe to report false after RemoveListener")
+	}
+
+	byReset := sig.Subscribe(func(ctx context.Context, v int) {})
+	sig.Reset()
+	if byReset.Active() {
+		t.Fatal("Expected subscription to be inactive after Reset")
+	}
+}
+
+func TestSubscription_DuplicateKeyReturnsNil(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	sig.AddListener(func(ctx context.Context, v int) {}, "k")
+	sub := sig.Subscribe(func(ctx context.Context, v int) {}, "k")
+	if sub != nil {
+		t.Fatal("Expected nil subscription for duplicate key")
+	}
+	if sub.Active() || sub.Unsubscribe() {
+		t.Fatal("Expected nil subscription to be inactive")
+	}
+	if sig.Len() != 1 {
+		t.Fatalf("Expected 1 listener, got %d", sig.Len())
+	}
+}
+
+func TestAsyncSignal_Subscribe(t *testing.T) {
+	sig := signals.New[int]()
+
+	var wg sync.WaitGroup
+	calls := 0
+	var mu sync.Mutex
+	sub := sig.Subscribe(func(ctx context.Context, v int) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		wg.Done()
+	})
+
+	wg.Add(1)
+	sig.Emit(context.Background(), 1)
+	wg.Wait()
+
+	sub.Unsubscribe()
+	if err := sig.EmitAndWait(context.Background(), 2); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls)
+	}
+	if !sig.IsEmpty() {
+		t.Fatalf("Expected no listeners, got %d", sig.Len())
+	}
+}
+
+func TestSubscription_ConcurrentUnsubscribe(t *testing.T) {
+	sig := signals.New[int]()
+	sub := sig.SubscribeWithErr(func(ctx context.Context, v int) error { return nil })
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	removed := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sig.Emit(context.Background(), 1)
+			if sub.Unsubscribe() {
+				mu.Lock()
+				removed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if removed != 1 {
+		t.Fatalf("Expected exactly one Unsubscribe to succeed, got %d", removed)
+	}
+}
@@ -2,7 +2,9 @@ package signals
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
 )
 
 // SyncSignal implements synchronous signal emission, invoking all listeners
@@ -25,11 +27,21 @@ type SyncSignal[T any] struct {
 func (s *SyncSignal[T]) ensureBase() {
 	s.baseOnce.Do(func() {
 		if s.baseSignal == nil {
-			s.baseSignal = NewBaseSignal[T](nil)
+			s.baseSignal = newSyncBaseSignal[T](nil)
 		}
 	})
 }
 
+// newSyncBaseSignal creates the BaseSignal of a SyncSignal, resolving OrderDefault
+// to stable removal.
+func newSyncBaseSignal[T any](opts *SignalOptions) *BaseSignal[T] {
+	b := NewBaseSignal[T](opts)
+	if opts == nil || opts.ListenerOrder == OrderDefault {
+		b.stableOrder = true
+	}
+	return b
+}
+
 // AddListenerWithErr registers an error-returning listener that can report processing failures.
 // These listeners are particularly useful with TryEmit(), which can detect and return errors.
 //
@@ -43,32 +55,31 @@ func (s *SyncSignal[T]) ensureBase() {
 //
 // Note: When both listener and listenerErr are set, listenerErr takes precedence during TryEmit().
 func (s *BaseSignal[T]) AddListenerWithErr(listener SignalListenerErr[T], key ...string) int {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	if listener == nil {
 		panic("listener cannot be nil")
 	}
+	n, _ := s.add(withKey(keyedListener[T]{listenerErr: listener}, key))
+	return n
+}
 
-	if len(key) > 0 {
-		if _, ok := s.subscribersMap[key[0]]; ok {
-			return -1
-		}
-		s.ensureCapacity(1)
-		s.subscribersMap[key[0]] = struct{}{}
-		s.subscribers = append(s.subscribers, keyedListener[T]{
-			key:         key[0],
-			keyed:       true,
-			listenerErr: listener,
-		})
-	} else {
-		s.ensureCapacity(1)
-		s.subscribers = append(s.subscribers, keyedListener[T]{
-			listenerErr: listener,
-		})
+// AddListenerWithErrPriority registers an error-returning listener with a priority.
+// See BaseSignal.AddListenerWithPriority for how priorities order listeners.
+func (s *BaseSignal[T]) AddListenerWithErrPriority(listener SignalListenerErr[T], priority int, key ...string) int {
+	if listener == nil {
+		panic("listener cannot be nil")
 	}
+	n, _ := s.add(withKey(keyedListener[T]{listenerErr: listener, priority: priority}, key))
+	return n
+}
 
-	return len(s.subscribers)
+// AddOnceListenerWithErr registers a one-shot error-returning listener.
+// See BaseSignal.AddOnceListener for details.
+func (s *BaseSignal[T]) AddOnceListenerWithErr(listener SignalListenerErr[T], key ...string) int {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	n, _ := s.add(withKey(keyedListener[T]{listenerErr: listener, once: new(atomic.Bool)}, key))
+	return n
 }
 
 // AddListener registers a new listener. See BaseSignal.AddListener for details.
@@ -83,18 +94,81 @@ func (s *SyncSignal[T]) AddListenerWithErr(listener SignalListenerErr[T], key ..
 	return s.baseSignal.AddListenerWithErr(listener, key...)
 }
 
+// AddListenerWithPriority registers a listener with a priority. See BaseSignal.AddListenerWithPriority for details.
+func (s *SyncSignal[T]) AddListenerWithPriority(listener SignalListener[T], priority int, key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithPriority(listener, priority, key...)
+}
+
+// AddListenerWithErrPriority registers an error-returning listener with a priority. See BaseSignal.AddListenerWithErrPriority for details.
+func (s *SyncSignal[T]) AddListenerWithErrPriority(listener SignalListenerErr[T], priority int, key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErrPriority(listener, priority, key...)
+}
+
+// AddOnceListener registers a one-shot listener. See BaseSignal.AddOnceListener for details.
+func (s *SyncSignal[T]) AddOnceListener(listener SignalListener[T], key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddOnceListener(listener, key...)
+}
+
+// AddOnceListenerWithErr registers a one-shot error-returning listener. See BaseSignal.AddOnceListenerWithErr for details.
+func (s *SyncSignal[T]) AddOnceListenerWithErr(listener SignalListenerErr[T], key ...string) int {
+	s.ensureBase()
+	return s.baseSignal.AddOnceListenerWithErr(listener, key...)
+}
+
 // RemoveListener removes a keyed listener. See BaseSignal.RemoveListener for details.
 func (s *SyncSignal[T]) RemoveListener(key string) int {
 	s.ensureBase()
 	return s.baseSignal.RemoveListener(key)
 }
 
+// ReplaceListener replaces the listener registered under key. See BaseSignal.ReplaceListener for details.
+func (s *SyncSignal[T]) ReplaceListener(key string, listener SignalListener[T]) bool {
+	s.ensureBase()
+	return s.baseSignal.ReplaceListener(key, listener)
+}
+
+// ReplaceListenerWithErr replaces the listener registered under key with an error-returning listener. See BaseSignal.ReplaceListenerWithErr for details.
+func (s *SyncSignal[T]) ReplaceListenerWithErr(key string, listener SignalListenerErr[T]) bool {
+	s.ensureBase()
+	return s.baseSignal.ReplaceListenerWithErr(key, listener)
+}
+
+// RemoveListenersByTag removes every listener carrying tag. See BaseSignal.RemoveListenersByTag for details.
+func (s *SyncSignal[T]) RemoveListenersByTag(tag string) int {
+	s.ensureBase()
+	return s.baseSignal.RemoveListenersByTag(tag)
+}
+
+// RemoveListenersByPrefix removes every listener whose key starts with prefix. See BaseSignal.RemoveListenersByPrefix for details.
+func (s *SyncSignal[T]) RemoveListenersByPrefix(prefix string) int {
+	s.ensureBase()
+	return s.baseSignal.RemoveListenersByPrefix(prefix)
+}
+
 // Reset removes all subscribers. See BaseSignal.Reset for details.
 func (s *SyncSignal[T]) Reset() {
 	s.ensureBase()
 	s.baseSignal.Reset()
 }
 
+// SetPanicHandler sets the handler for listener panics. See BaseSignal.SetPanicHandler for details.
+//
+// The handler is only used when SignalOptions.IsolatePanics is set; otherwise listener
+// panics propagate to the caller of Emit or TryEmit.
+func (s *SyncSignal[T]) SetPanicHandler(handler func(*PanicError)) {
+	s.ensureBase()
+	s.baseSignal.SetPanicHandler(handler)
+}
+
+// Name returns the signal name. See BaseSignal.Name for details.
+func (s *SyncSignal[T]) Name() string {
+	s.ensureBase()
+	return s.baseSignal.Name()
+}
+
 // Len returns the current number of subscribers. See BaseSignal.Len for details.
 func (s *SyncSignal[T]) Len() int {
 	s.ensureBase()
@@ -108,38 +182,41 @@ func (s *SyncSignal[T]) IsEmpty() bool {
 }
 
 // Emit synchronously invokes all registered listeners with the given payload.
-// Listeners are called sequentially in the order they were registered (though order
-// may change after removals due to swap-remove optimization).
+// Listeners are called sequentially in the order they were registered. Removals keep
+// that order unless SignalOptions.ListenerOrder is OrderUnstable.
 //
 // The method blocks until all listeners have completed execution. If the provided
 // context is cancelled or times out, remaining listeners will not be invoked.
 //
+// With SignalOptions.IsolatePanics, a panicking listener is reported to the panic
+// handler and the remaining listeners are still invoked. While the signal is paused,
+// the emit is discarded or buffered according to SignalOptions.PausePolicy, and muted
+// listeners are skipped.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout. Checked before each listener invocation.
 //   - payload: Data to pass to all listeners
 func (s *SyncSignal[T]) Emit(ctx context.Context, payload T) {
+	s.emit(ctx, payload, nil)
+}
+
+// emit implements Emit, invoking only the listeners selected by opts when it is
+// not nil.
+func (s *SyncSignal[T]) emit(ctx context.Context, payload T, opts *EmitOptions) {
 	s.ensureBase()
 	// If context already canceled, bail out early
 	if ctx != nil && ctx.Err() != nil {
 		return
 	}
-	s.baseSignal.mu.RLock()
-	subscribers := s.baseSignal.subscribers
-	if len(subscribers) == 0 {
-		s.baseSignal.mu.RUnlock()
+	if held, _ := s.baseSignal.hold(ctx, payload, opts, true); held {
 		return
 	}
-	var local [4]keyedListener[T]
-	var snapshot []keyedListener[T]
-	if len(subscribers) <= len(local) {
-		snapshot = local[:len(subscribers)]
-		copy(snapshot, subscribers)
-		s.baseSignal.mu.RUnlock()
-	} else {
-		snapshot = make([]keyedListener[T], len(subscribers))
-		copy(snapshot, subscribers)
-		s.baseSignal.mu.RUnlock()
-	}
+	s.invoke(ctx, payload, opts)
+}
+
+// invoke calls the listeners selected by opts for emit and Resume.
+func (s *SyncSignal[T]) invoke(ctx context.Context, payload T, opts *EmitOptions) {
+	snapshot := s.baseSignal.load()
 	for i := range snapshot {
 		// Stop invoking further listeners if the context is canceled
 		if ctx != nil {
@@ -148,16 +225,34 @@ func (s *SyncSignal[T]) Emit(ctx context.Context, payload T) {
 			}
 		}
 		sub := &snapshot[i]
-		if sub.listenerErr != nil {
-			_ = sub.listenerErr(ctx, payload)
+		if sub.muted || !opts.selects(sub.meta) {
 			continue
 		}
-		if sub.listener != nil {
-			sub.listener(ctx, payload)
+		if s.baseSignal.isolatePanics {
+			s.deliverIsolated(ctx, sub, payload)
+			continue
 		}
+		if !s.baseSignal.claim(sub) {
+			continue
+		}
+		_ = s.baseSignal.invokeListener(ctx, sub, payload)
 	}
 }
 
+// deliverIsolated invokes a single listener for Emit, recovering a panic and passing
+// it to the panic handler.
+func (s *SyncSignal[T]) deliverIsolated(ctx context.Context, sub *keyedListener[T], payload T) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.baseSignal.handlePanic(r, sub.key)
+		}
+	}()
+	if !s.baseSignal.claim(sub) {
+		return
+	}
+	_ = s.baseSignal.invokeListener(ctx, sub, payload)
+}
+
 // TryEmit synchronously invokes all registered listeners and returns any errors encountered.
 // This method is similar to Emit but provides error handling and propagation capabilities.
 //
@@ -185,7 +280,21 @@ func (s *SyncSignal[T]) Emit(ctx context.Context, payload T) {
 //   - nil if all listeners complete successfully
 //   - context.Err() if the context is cancelled or times out
 //   - The first non-nil error returned by any SignalListenerErr
+//   - A *PanicError if a listener panics and SignalOptions.IsolatePanics is set
+//   - A *ListenerTimeoutError if a listener registered with ListenerOptions.StrictTimeout
+//     is still running at its deadline
+//   - A *CircuitOpenError, matching ErrCircuitOpen, if a listener was skipped because
+//     its circuit breaker is open and no other listener failed; skipped listeners do
+//     not stop the emission
+//   - ErrPaused if the signal is paused, or Resume is still delivering buffered emits;
+//     TryEmit is never buffered because its result could not be reported
 func (s *SyncSignal[T]) TryEmit(ctx context.Context, payload T) error {
+	return s.tryEmit(ctx, payload, nil)
+}
+
+// tryEmit implements TryEmit, invoking only the listeners selected by opts when it
+// is not nil.
+func (s *SyncSignal[T]) tryEmit(ctx context.Context, payload T, opts *EmitOptions) error {
 	s.ensureBase()
 	// If context already canceled, bail out early with error
 	if ctx != nil {
@@ -193,27 +302,13 @@ func (s *SyncSignal[T]) TryEmit(ctx context.Context, payload T) error {
 			return err
 		}
 	}
-
-	s.baseSignal.mu.RLock()
-	subscribers := s.baseSignal.subscribers
-	if len(subscribers) == 0 {
-		s.baseSignal.mu.RUnlock()
-		if ctx != nil {
-			return ctx.Err()
-		}
-		return nil
-	}
-	var local [4]keyedListener[T]
-	var snapshot []keyedListener[T]
-	if len(subscribers) <= len(local) {
-		snapshot = local[:len(subscribers)]
-		copy(snapshot, subscribers)
-		s.baseSignal.mu.RUnlock()
-	} else {
-		snapshot = make([]keyedListener[T], len(subscribers))
-		copy(snapshot, subscribers)
-		s.baseSignal.mu.RUnlock()
+	if held, err := s.baseSignal.hold(ctx, payload, opts, false); held {
+		return err
 	}
+
+	// skipped is the first error of a listener skipped by its circuit breaker
+	var skipped error
+	snapshot := s.baseSignal.load()
 	for i := range snapshot {
 		// Stop invoking further listeners if the context is canceled
 		if ctx != nil {
@@ -222,18 +317,91 @@ func (s *SyncSignal[T]) TryEmit(ctx context.Context, payload T) error {
 			}
 		}
 		sub := &snapshot[i]
-		if sub.listenerErr != nil {
-			if err := sub.listenerErr(ctx, payload); err != nil {
-				return err
-			}
+		if sub.muted || !opts.selects(sub.meta) {
+			continue
+		}
+		var err error
+		switch {
+		case s.baseSignal.isolatePanics:
+			err = s.baseSignal.call(ctx, sub, payload)
+		case !s.baseSignal.claim(sub):
+			continue
+		default:
+			err = s.baseSignal.invokeListener(ctx, sub, payload)
+		}
+		if err == nil {
 			continue
 		}
-		if sub.listener != nil {
-			sub.listener(ctx, payload)
+		if !errors.Is(err, ErrCircuitOpen) {
+			return err
+		}
+		if skipped == nil {
+			skipped = err
 		}
 	}
 	if ctx != nil {
-		return ctx.Err()
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	return skipped
+}
+
+// TryEmitAll synchronously invokes every registered listener and returns all errors
+// encountered. Unlike TryEmit, a failing listener does not stop the emission, which
+// suits validation and notification fan-outs where every listener must run.
+//
+// Each listener error is wrapped in a *ListenerError carrying the listener key and
+// position, and all of them are combined with errors.Join. Use errors.As to inspect
+// which listeners failed.
+//
+// Context cancellation still stops the emission: remaining listeners are skipped and
+// ctx.Err() is added to the returned errors. With SignalOptions.IsolatePanics, a
+// panicking listener is reported as a *ListenerError wrapping a *PanicError and the
+// remaining listeners still run. If the signal is paused, TryEmitAll returns ErrPaused
+// without invoking any listener.
+//
+// Example:
+//
+//	err := signal.TryEmitAll(ctx, form)
+//	var lerr *signals.ListenerError
+//	if errors.As(err, &lerr) {
+//		log.Printf("validator %q failed: %v", lerr.Key, lerr.Err)
+//	}
+func (s *SyncSignal[T]) TryEmitAll(ctx context.Context, payload T) error {
+	s.ensureBase()
+	if ctx != nil {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	if held, err := s.baseSignal.hold(ctx, payload, nil, false); held {
+		return err
+	}
+
+	snapshot := s.baseSignal.load()
+
+	var errs []error
+	for i := range snapshot {
+		if ctx != nil {
+			if err := ctx.Err(); err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+		sub := &snapshot[i]
+		var err error
+		switch {
+		case sub.muted:
+		case s.baseSignal.isolatePanics:
+			err = s.baseSignal.call(ctx, sub, payload)
+		case !s.baseSignal.claim(sub):
+		default:
+			err = s.baseSignal.invokeListener(ctx, sub, payload)
+		}
+		if err != nil {
+			errs = append(errs, newListenerError(sub.key, i, err))
+		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
@@ -0,0 +1,156 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestSyncSignal_PanicPropagatesByDefault(t *testing.T) {
+	sig := signals.NewSync[int]()
+
+	called := false
+	sig.AddListener(func(ctx context.Context, v int) { panic("boom") })
+	sig.AddListener(func(ctx context.Context, v int) { called = true })
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Fatalf("Expected panic to propagate to the emitter, got %v", r)
+			}
+		}()
+		sig.Emit(context.Background(), 1)
+	}()
+
+	if called {
+		t.Fatal("Expected remaining listeners to be skipped without isolation")
+	}
+}
+
+func TestSyncSignal_IsolatedEmitContinuesAfterPanic(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{
+		Name:          "user.saved",
+		IsolatePanics: true,
+	})
+
+	var reports []*signals.PanicError
+	sig.SetPanicHandler(func(p *signals.PanicError) {
+		reports = append(reports, p)
+	})
+
+	order := make([]int, 0, 3)
+	sig.AddListener(func(ctx context.Context, v int) { order = append(order, 1) })
+	sig.AddListener(func(ctx context.Context, v int) { panic("boom") }, "validator")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { panic("err boom") }, "writer")
+	sig.AddListener(func(ctx context.Context, v int) { order = append(order, 3) })
+
+	sig.Emit(context.Background(), 1)
+
+	if len(order) != 2 || order[0] != 1 || order[1] != 3 {
+		t.Fatalf("Expected listeners around the panics to run, got %v", order)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("Expected 2 panic reports, got %d", len(reports))
+	}
+	if reports[0].Key != "validator" || reports[0].Value != "boom" || reports[0].Signal != "user.saved" {
+		t.Fatalf("Unexpected first report %+v", reports[0])
+	}
+	if reports[1].Key != "writer" || reports[1].Value != "err boom" {
+		t.Fatalf("Unexpected second report %+v", reports[1])
+	}
+	if !strings.Contains(string(reports[0].Stack), "TestSyncSignal_IsolatedEmitContinuesAfterPanic") {
+		t.Fatalf("Expected stack trace to include the panicking listener, got:\n%s", reports[0].Stack)
+	}
+}
+
+func TestSyncSignal_IsolatedEmitWithoutHandler(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+
+	called := false
+	sig.AddListener(func(ctx context.Context, v int) { panic("boom") })
+	sig.AddListener(func(ctx context.Context, v int) { called = true })
+
+	sig.Emit(context.Background(), 1)
+
+	if !called {
+		t.Fatal("Expected listener after the panic to run")
+	}
+}
+
+func TestSyncSignal_IsolatedTryEmitReturnsPanicError(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+
+	handled := false
+	sig.SetPanicHandler(func(p *signals.PanicError) { handled = true })
+
+	called := false
+	sig.AddListener(func(ctx context.Context, v int) { panic("boom") }, "k")
+	sig.AddListener(func(ctx context.Context, v int) { called = true })
+
+	err := sig.TryEmit(context.Background(), 1)
+	var p *signals.PanicError
+	if !errors.As(err, &p) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if p.Key != "k" || p.Value != "boom" {
+		t.Fatalf("Unexpected panic error %+v", p)
+	}
+	if called {
+		t.Fatal("Expected TryEmit to stop at the panicking listener")
+	}
+	if handled {
+		t.Fatal("Expected TryEmit to return the panic instead of calling the handler")
+	}
+}
+
+func TestSyncSignal_IsolatedTryEmitListenerError(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+
+	errBoom := errors.New("boom")
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return errBoom })
+
+	if err := sig.TryEmit(context.Background(), 1); !errors.Is(err, errBoom) {
+		t.Fatalf("Expected listener error, got %v", err)
+	}
+}
+
+func TestSyncSignal_IsolatedEmitRepanic(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{
+		IsolatePanics:  true,
+		RepanicOnPanic: true,
+	})
+
+	handled := false
+	sig.SetPanicHandler(func(p *signals.PanicError) { handled = true })
+	sig.AddListener(func(ctx context.Context, v int) { panic("boom") }, "k")
+
+	defer func() {
+		p, ok := recover().(*signals.PanicError)
+		if !ok || p.Key != "k" {
+			t.Fatalf("Expected re-panic with *PanicError, got %v", p)
+		}
+		if !handled {
+			t.Fatal("Expected panic handler to run before re-panicking")
+		}
+	}()
+	sig.Emit(context.Background(), 1)
+	t.Fatal("Expected Emit to re-panic")
+}
+
+func TestSyncSignal_IsolatedEmitZeroAllocations(t *testing.T) {
+	sig := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+	sig.AddListener(func(ctx context.Context, v int) {})
+	sig.AddListenerWithErr(func(ctx context.Context, v int) error { return nil })
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		sig.Emit(context.Background(), 1)
+		_ = sig.TryEmit(context.Background(), 1)
+	})
+
+	if allocs != 0 {
+		t.Fatalf("Expected zero allocations with panic isolation, got %f", allocs)
+	}
+}
@@ -0,0 +1,184 @@
+package signals_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maniartech/signals"
+)
+
+func TestTryEmitAll_RunsEveryListener(t *testing.T) {
+	s := signals.NewSync[int]()
+
+	errName := errors.New("name is required")
+	errEmail := errors.New("email is invalid")
+	called := 0
+	s.AddListenerWithErr(func(ctx context.Context, v int) error { called++; return errName }, "name")
+	s.AddListener(func(ctx context.Context, v int) { called++ })
+	s.AddListenerWithErr(func(ctx context.Context, v int) error { called++; return nil }, "age")
+	s.AddListenerWithErr(func(ctx context.Context, v int) error { called++; return errEmail }, "email")
+
+	err := s.TryEmitAll(context.Background(), 1)
+
+	if called != 4 {
+		t.Fatalf("Expected all 4 listeners to run, got %d", called)
+	}
+	if !errors.Is(err, errName) || !errors.Is(err, errEmail) {
+		t.Fatalf("Expected joined error with both failures, got %v", err)
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("Expected errors.Join result, got %T", err)
+	}
+	errs := joined.Unwrap()
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %d", len(errs))
+	}
+	var first, second *signals.ListenerError
+	if !errors.As(errs[0], &first) || !errors.As(errs[1], &second) {
+		t.Fatalf("Expected *ListenerError values, got %v", errs)
+	}
+	if first.Key != "name" || first.Index != 0 || !errors.Is(first, errName) {
+		t.Fatalf("Unexpected first listener error %+v", first)
+	}
+	if second.Key != "email" || second.Index != 3 || !errors.Is(second, errEmail) {
+		t.Fatalf("Unexpected second listener error %+v", second)
+	}
+}
+
+func TestTryEmitAll_NilWhenAllSucceed(t *testing.T) {
+	s := signals.NewSync[int]()
+	s.AddListenerWithErr(func(ctx context.Context, v int) error { return nil })
+	s.AddListener(func(ctx context.Context, v int) {})
+
+	if err := s.TryEmitAll(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+}
+
+func TestTryEmitAll_NoListeners(t *testing.T) {
+	s := signals.NewSync[int]()
+
+	if err := s.TryEmitAll(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+}
+
+func TestTryEmitAll_ContextAlreadyCanceled(t *testing.T) {
+	s := signals.NewSync[int]()
+	called := false
+	s.AddListener(func(ctx context.Context, v int) { called = true })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := s.TryEmitAll(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if called {
+		t.Fatal("Expected no listeners to run")
+	}
+}
+
+func TestTryEmitAll_StopsOnCancelMidIteration(t *testing.T) {
+	s := signals.NewSync[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errFirst := errors.New("first")
+	called := 0
+	s.AddListenerWithErr(func(ctx context.Context, v int) error {
+		called++
+		cancel()
+		return errFirst
+	})
+	s.AddListener(func(ctx context.Context, v int) { called++ })
+
+	err := s.TryEmitAll(ctx, 1)
+	if !errors.Is(err, errFirst) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected listener error and context.Canceled, got %v", err)
+	}
+	if called != 1 {
+		t.Fatalf("Expected listeners after cancellation to be skipped, got %d calls", called)
+	}
+}
+
+func TestTryEmitAll_ManyListeners(t *testing.T) {
+	s := signals.NewSync[int]()
+
+	errOdd := errors.New("odd")
+	for i := 0; i < 10; i++ {
+		if i%2 == 1 {
+			s.AddListenerWithErr(func(ctx context.Context, v int) error { return errOdd })
+			continue
+		}
+		s.AddListenerWithErr(func(ctx context.Context, v int) error { return nil })
+	}
+
+	err := s.TryEmitAll(context.Background(), 1)
+	errs := err.(interface{ Unwrap() []error }).Unwrap()
+	if len(errs) != 5 {
+		t.Fatalf("Expected 5 errors, got %d", len(errs))
+	}
+	for n, e := range errs {
+		var lerr *signals.ListenerError
+		if !errors.As(e, &lerr) || lerr.Index != 2*n+1 {
+			t.Fatalf("Expected listener error at position %d, got %v", 2*n+1, e)
+		}
+	}
+}
+
+func TestTryEmitAll_IsolatedPanic(t *testing.T) {
+	s := signals.NewSyncWithOptions[int](&signals.SignalOptions{IsolatePanics: true})
+
+	called := false
+	s.AddListener(func(ctx context.Context, v int) { panic("boom") }, "p")
+	s.AddListener(func(ctx context.Context, v int) { called = true })
+
+	err := s.TryEmitAll(context.Background(), 1)
+
+	var lerr *signals.ListenerError
+	var perr *signals.PanicError
+	if !errors.As(err, &lerr) || !errors.As(err, &perr) {
+		t.Fatalf("Expected ListenerError wrapping PanicError, got %v", err)
+	}
+	if lerr.Key != "p" || lerr.Index != 0 || perr.Value != "boom" {
+		t.Fatalf("Unexpected errors %+v %+v", lerr, perr)
+	}
+	if !called {
+		t.Fatal("Expected listener after the panic to run")
+	}
+}
+
+func TestTryEmitAll_ZeroAllocations(t *testing.T) {
+	s := signals.NewSync[int]()
+	s.AddListener(func(ctx context.Context, v int) {})
+	s.AddListenerWithErr(func(ctx context.Context, v int) error { return nil })
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		_ = s.TryEmitAll(context.Background(), 1)
+	})
+
+	if allocs != 0 {
+		t.Fatalf("Expected zero allocations for successful TryEmitAll, got %f", allocs)
+	}
+}
+
+func TestAsyncSignal_EmitAndWaitWrapsListenerErrors(t *testing.T) {
+	s := signals.New[int]()
+
+	errBoom := errors.New("boom")
+	s.AddListener(func(ctx context.Context, v int) {})
+	s.AddListenerWithErr(func(ctx context.Context, v int) error { return errBoom }, "analytics")
+
+	err := s.EmitAndWait(context.Background(), 1)
+
+	var lerr *signals.ListenerError
+	if !errors.As(err, &lerr) {
+		t.Fatalf("Expected *ListenerError, got %v", err)
+	}
+	if lerr.Key != "analytics" || lerr.Index != 1 || !errors.Is(lerr, errBoom) {
+		t.Fatalf("Unexpected listener error %+v", lerr)
+	}
+}
@@ -0,0 +1,207 @@
+package signals
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Subscription is a handle to a listener registered with Subscribe or
+// SubscribeWithErr. It allows removing the listener without giving it a key.
+//
+// A Subscription is safe for concurrent use. A nil *Subscription, as returned when
+// registration fails, is never active and Unsubscribe on it is a no-op.
+type Subscription struct {
+	removed atomic.Bool
+	remove  func() bool
+
+	// stop releases the context registration of listeners added with
+	// AddListenerContext; guarded by the signal's mutex
+	stop func() bool
+}
+
+// Unsubscribe removes the listener from its signal. It is idempotent and may be
+// called from inside the listener itself. Emits that started before the call may
+// still deliver to the listener.
+//
+// Returns true if this call removed the listener, false if it had already been
+// removed (by Unsubscribe, RemoveListener, Reset or a one-shot delivery).
+func (s *Subscription) Unsubscribe() bool {
+	if s == nil {
+		return false
+	}
+	return s.remove()
+}
+
+// Active reports whether the listener is still registered.
+func (s *Subscription) Active() bool {
+	return s != nil && !s.removed.Load()
+}
+
+// Subscribe registers a listener like AddListener and returns a Subscription that
+// removes it. Unlike keys, subscriptions also work for unkeyed listeners.
+//
+// Returns nil if a listener with the same key already exists.
+//
+// Example:
+//
+//	signal := signals.New[Event]()
+//	sub := signal.Subscribe(func(ctx context.Context, e Event) {
+//		fmt.Println("event:", e.Name)
+//	})
+//	defer sub.Unsubscribe()
+func (s *BaseSignal[T]) Subscribe(listener SignalListener[T], key ...string) *Subscription {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.subscribe(nil, withKey(keyedListener[T]{listener: listener}, key))
+}
+
+// SubscribeWithErr registers an error-returning listener like AddListenerWithErr and
+// returns a Subscription that removes it. See Subscribe for details.
+func (s *BaseSignal[T]) SubscribeWithErr(listener SignalListenerErr[T], key ...string) *Subscription {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.subscribe(nil, withKey(keyedListener[T]{listenerErr: listener}, key))
+}
+
+// AddListenerContext registers a listener that is removed automatically when ctx is
+// done, e.g. a listener bound to a request or a WebSocket connection. No goroutine is
+// started per listener; the removal is registered with the context itself (see
+// context.AfterFunc) and released when the listener is removed earlier.
+//
+// The returned Subscription can be used to remove the listener before ctx is done.
+// Returns nil, without registering the listener, if ctx is already done or a listener
+// with the same key already exists. A nil ctx never ends.
+//
+// Example:
+//
+//	func serveUpdates(conn *websocket.Conn, updates *signals.AsyncSignal[Update]) {
+//		ctx, cancel := context.WithCancel(context.Background())
+//		defer cancel() // removes the listener
+//		updates.AddListenerContext(ctx, func(ctx context.Context, u Update) {
+//			conn.WriteJSON(u)
+//		})
+//		readLoop(conn)
+//	}
+func (s *BaseSignal[T]) AddListenerContext(ctx context.Context, listener SignalListener[T], key ...string) *Subscription {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.subscribe(ctx, withKey(keyedListener[T]{listener: listener}, key))
+}
+
+// AddListenerWithErrContext registers an error-returning listener that is removed
+// automatically when ctx is done. See AddListenerContext for details.
+func (s *BaseSignal[T]) AddListenerWithErrContext(ctx context.Context, listener SignalListenerErr[T], key ...string) *Subscription {
+	if listener == nil {
+		panic("listener cannot be nil")
+	}
+	return s.subscribe(ctx, withKey(keyedListener[T]{listenerErr: listener}, key))
+}
+
+// subscribe registers sub with a new Subscription, or returns nil if it cannot be
+// added. A non-nil ctx removes the listener when it is done.
+func (s *BaseSignal[T]) subscribe(ctx context.Context, sub keyedListener[T]) *Subscription {
+	if ctx != nil && ctx.Err() != nil {
+		return nil
+	}
+	handle := &Subscription{}
+	handle.remove = func() bool {
+		return s.removeHandle(handle)
+	}
+	sub.handle = handle
+	if _, err := s.add(sub); err != nil {
+		return nil
+	}
+	if ctx == nil {
+		return handle
+	}
+
+	// The listener is registered before the context callback so that a context
+	// ending right away cannot run the callback ahead of the registration.
+	stop := context.AfterFunc(ctx, func() {
+		handle.Unsubscribe()
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if handle.removed.Load() {
+		// Removed in the meantime; nothing will release the registration
+		stop()
+	} else {
+		handle.stop = stop
+	}
+	return handle
+}
+
+// release marks the subscription inactive and drops its context registration. The
+// caller must hold the signal's mutex.
+func (s *Subscription) release() {
+	s.removed.Store(true)
+	if s.stop != nil {
+		s.stop()
+		s.stop = nil
+	}
+}
+
+// removeHandle removes the listener owning handle and reports whether it was found.
+func (s *BaseSignal[T]) removeHandle(handle *Subscription) bool {
+	defer s.runHooks()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.subscribers {
+		if s.subscribers[i].handle == handle {
+			s.removeAt(i)
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe adds a listener and returns its Subscription. Promoted from baseSignal.
+func (s *SyncSignal[T]) Subscribe(listener SignalListener[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.Subscribe(listener, key...)
+}
+
+// SubscribeWithErr adds an error-returning listener and returns its Subscription. Promoted from baseSignal.
+func (s *SyncSignal[T]) SubscribeWithErr(listener SignalListenerErr[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.SubscribeWithErr(listener, key...)
+}
+
+// Subscribe adds a listener and returns its Subscription. Promoted from baseSignal.
+func (s *AsyncSignal[T]) Subscribe(listener SignalListener[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.Subscribe(listener, key...)
+}
+
+// SubscribeWithErr adds an error-returning listener and returns its Subscription. Promoted from baseSignal.
+func (s *AsyncSignal[T]) SubscribeWithErr(listener SignalListenerErr[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.SubscribeWithErr(listener, key...)
+}
+
+// AddListenerContext adds a listener removed when ctx is done. Promoted from baseSignal.
+func (s *SyncSignal[T]) AddListenerContext(ctx context.Context, listener SignalListener[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.AddListenerContext(ctx, listener, key...)
+}
+
+// AddListenerWithErrContext adds an error-returning listener removed when ctx is done. Promoted from baseSignal.
+func (s *SyncSignal[T]) AddListenerWithErrContext(ctx context.Context, listener SignalListenerErr[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErrContext(ctx, listener, key...)
+}
+
+// AddListenerContext adds a listener removed when ctx is done. Promoted from baseSignal.
+func (s *AsyncSignal[T]) AddListenerContext(ctx context.Context, listener SignalListener[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.AddListenerContext(ctx, listener, key...)
+}
+
+// AddListenerWithErrContext adds an error-returning listener removed when ctx is done. Promoted from baseSignal.
+func (s *AsyncSignal[T]) AddListenerWithErrContext(ctx context.Context, listener SignalListenerErr[T], key ...string) *Subscription {
+	s.ensureBase()
+	return s.baseSignal.AddListenerWithErrContext(ctx, listener, key...)
+}